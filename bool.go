@@ -1,103 +1,62 @@
 package extratypes
 
-import (
-	"bytes"
-	"database/sql"
-	"database/sql/driver"
-	"encoding/json"
-	"fmt"
-	"strconv"
-)
+import "database/sql/driver"
 
 // Bool contain a boolean data that can be null, and also string
-// on JSON and SQL, but value will be converted into bool type
-type Bool struct {
-	sql.NullBool
-	Val bool `json:"val" toml:"val"`
-	Nil bool `json:"nil" toml:"nil"`
-}
-
-// Scan implements the Scanner interface.
-func (b *Bool) Scan(value interface{}) error {
-	if value == nil {
-		b.Val = false
-		b.Nil = true
-		return nil
-	}
-
-	b.Nil = false
-	b.Val = asBool(value)
-	return nil
-}
+// on JSON and SQL, but value will be converted into bool type.
+//
+// Bool is a Null[bool] that also has the three-valued logic of SQL, where
+// nil is unknown.
+type Bool Null[bool]
 
 func (b Bool) String() string {
-	if b.Nil {
-		return "nil"
-	}
-	return strconv.FormatBool(b.Val)
+	return Null[bool](b).String()
 }
 
-// Value implements the driver Valuer interface.
+// Value implements the driver Valuer interface, in BoolSQLFormat.
 func (b Bool) Value() (driver.Value, error) {
-	if b.Nil {
-		return nil, nil
-	}
+	return b.value(BoolSQLFormat)
+}
 
-	return b.Val, nil
+// Scan implements the Scanner interface.
+func (b *Bool) Scan(v interface{}) error {
+	return (*Null[bool])(b).Scan(v)
 }
 
-// MarshalJSON implement the Marshaler interface
-func (b Bool) MarshalJSON() ([]byte, error) {
-	if b.Nil {
-		return json.Marshal(nil)
-	}
+// ScanWith is like Scan, but converts v by the rules of opts
+func (b *Bool) ScanWith(v interface{}, opts Options) error {
+	return (*Null[bool])(b).ScanWith(v, opts)
+}
 
-	return json.Marshal(b.Val)
+// MarshalJSON implement the Marshaler interface, in BoolJSONFormat
+func (b Bool) MarshalJSON() ([]byte, error) {
+	return b.marshalJSON(BoolJSONFormat)
 }
 
 // UnmarshalJSON implement the un-Marshaler interface
-func (b *Bool) UnmarshalJSON(buf []byte) error {
-	var v interface{}
-	err := json.Unmarshal(buf, &v)
-	if err != nil {
-		return err
-	}
-
-	if v == nil {
-		b.Nil = true
-		b.Val = false
-		return nil
-	}
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	return (*Null[bool])(b).UnmarshalJSON(data)
+}
 
-	b.Val = asBool(v)
-	return nil
+// UnmarshalJSONWith is like UnmarshalJSON, but converts data by the rules
+// of opts
+func (b *Bool) UnmarshalJSONWith(data []byte, opts Options) error {
+	return (*Null[bool])(b).UnmarshalJSONWith(data, opts)
 }
 
-// MarshalText implement Text Marshaller interface
+// MarshalText implement Text Marshaller interface, in BoolTextFormat
 func (b Bool) MarshalText() ([]byte, error) {
-	if b.Nil {
-		return []byte(""), nil
-	}
-
-	return asByteSlice(b.String()), nil
+	return b.marshalText(BoolTextFormat)
 }
 
-// UnmarshalText implement the text un-Marshaller interface
-func (b *Bool) UnmarshalText(buf []byte) error {
-	if buf == nil || bytes.Compare(buf, []byte("")) == 0 ||
-		bytes.Compare(buf, []byte("null")) == 0 ||
-		bytes.Compare(buf, []byte("nil")) == 0 {
-
-		b.Nil = true
-		return nil
-	}
-
-	result, err := toType(buf, &b.Val)
-	if err != nil {
-		return err
-	}
-	fmt.Printf("buf: %s | result: %t | b.Val: %t\n", buf, result, b.Val)
+// UnmarshalText implement the text un-Marshaller interface.
+// An empty text, "null" and "nil" are all considered as nil.
+func (b *Bool) UnmarshalText(data []byte) error {
+	return (*Null[bool])(b).UnmarshalText(data)
+}
 
-	b.Nil = result
-	return nil
+// UnmarshalTextWith is like UnmarshalText, but converts data by the rules
+// of opts
+func (b *Bool) UnmarshalTextWith(data []byte, opts Options) error {
+	return (*Null[bool])(b).UnmarshalTextWith(data, opts)
 }
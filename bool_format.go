@@ -0,0 +1,221 @@
+package extratypes
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+)
+
+// BoolFormat is the way that a Bool is written on JSON, Text and SQL. Any
+// of the formats is read back by the vocabulary of the Bool.
+type BoolFormat int
+
+// The available formats of a Bool
+const (
+	// BoolFormatTrueFalse is true and false, a JSON boolean and a bool on
+	// SQL
+	BoolFormatTrueFalse BoolFormat = iota
+	// BoolFormatYN is "Y" and "N", such as an Oracle CHAR(1)
+	BoolFormatYN
+	// BoolFormatInt is 1 and 0, such as a MySQL TINYINT
+	BoolFormatInt
+	// BoolFormatYesNo is "yes" and "no"
+	BoolFormatYesNo
+)
+
+var (
+	// BoolJSONFormat is the format of Bool on JSON
+	BoolJSONFormat = BoolFormatTrueFalse
+	// BoolTextFormat is the format of Bool on Text
+	BoolTextFormat = BoolFormatTrueFalse
+	// BoolSQLFormat is the format of Bool on SQL
+	BoolSQLFormat = BoolFormatTrueFalse
+)
+
+// boolFormatWords are the words that the formats write. They are read even
+// when Options.BoolVocabulary replaces the default one, so a Bool always
+// reads back what it wrote.
+var boolFormatWords = func() BoolVocabulary {
+	formats := []BoolFormat{BoolFormatTrueFalse, BoolFormatYN, BoolFormatInt, BoolFormatYesNo}
+
+	var trueWords, falseWords []string
+	for _, f := range formats {
+		trueWords = append(trueWords, f.format(true))
+		falseWords = append(falseWords, f.format(false))
+	}
+
+	return NewBoolVocabulary(trueWords, falseWords)
+}()
+
+// value returns b in f, as it is written to JSON and SQL
+func (f BoolFormat) value(b bool) interface{} {
+	switch f {
+	case BoolFormatYN:
+		if b {
+			return "Y"
+		}
+		return "N"
+	case BoolFormatInt:
+		if b {
+			return int64(1)
+		}
+		return int64(0)
+	case BoolFormatYesNo:
+		if b {
+			return "yes"
+		}
+		return "no"
+	}
+
+	return b
+}
+
+// format returns b as a string in f
+func (f BoolFormat) format(b bool) string {
+	switch v := f.value(b).(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	}
+
+	return strconv.FormatBool(b)
+}
+
+func (b Bool) value(f BoolFormat) (driver.Value, error) {
+	if b.Nil {
+		return nil, nil
+	}
+
+	return f.value(b.Val), nil
+}
+
+func (b Bool) marshalJSON(f BoolFormat) ([]byte, error) {
+	if b.Nil {
+		return json.Marshal(nil)
+	}
+
+	return json.Marshal(f.value(b.Val))
+}
+
+func (b Bool) marshalText(f BoolFormat) ([]byte, error) {
+	if b.Nil {
+		return []byte(""), nil
+	}
+
+	return []byte(f.format(b.Val)), nil
+}
+
+// BoolFormats are the formats of a FormattedBool
+type BoolFormats struct {
+	JSON BoolFormat
+	Text BoolFormat
+	SQL  BoolFormat
+}
+
+// BoolFormatter selects the formats of a FormattedBool. It is implemented
+// by an empty struct, that is used only as a type parameter, the same way
+// as DurationFormatter.
+type BoolFormatter interface {
+	BoolFormats() BoolFormats
+}
+
+// FormattedBool is a Bool that is written in the formats of F, instead of
+// BoolJSONFormat, BoolTextFormat and BoolSQLFormat, so each field can
+// have its own formats.
+type FormattedBool[F BoolFormatter] Bool
+
+// The formats that are used on JSON, Text and SQL at once
+type (
+	inYN    struct{}
+	inInt   struct{}
+	inYesNo struct{}
+)
+
+func (inYN) BoolFormats() BoolFormats {
+	return sameBoolFormats(BoolFormatYN)
+}
+
+func (inInt) BoolFormats() BoolFormats {
+	return sameBoolFormats(BoolFormatInt)
+}
+
+func (inYesNo) BoolFormats() BoolFormats {
+	return sameBoolFormats(BoolFormatYesNo)
+}
+
+func sameBoolFormats(f BoolFormat) BoolFormats {
+	return BoolFormats{JSON: f, Text: f, SQL: f}
+}
+
+// Bools that use a single format on JSON, Text and SQL
+type (
+	// BoolYN is written as "Y" and "N"
+	BoolYN = FormattedBool[inYN]
+	// BoolInt is written as 1 and 0
+	BoolInt = FormattedBool[inInt]
+	// BoolYesNo is written as "yes" and "no"
+	BoolYesNo = FormattedBool[inYesNo]
+)
+
+func (b FormattedBool[F]) formats() BoolFormats {
+	var f F
+	return f.BoolFormats()
+}
+
+// Bool returns b as a Bool, for its three-valued logic
+func (b FormattedBool[F]) Bool() Bool {
+	return Bool(b)
+}
+
+func (b FormattedBool[F]) String() string {
+	return Bool(b).String()
+}
+
+// Value implements the driver Valuer interface, in the SQL format of F.
+func (b FormattedBool[F]) Value() (driver.Value, error) {
+	return Bool(b).value(b.formats().SQL)
+}
+
+// Scan implements the Scanner interface.
+func (b *FormattedBool[F]) Scan(v interface{}) error {
+	return (*Bool)(b).Scan(v)
+}
+
+// ScanWith is like Scan, but converts v by the rules of opts
+func (b *FormattedBool[F]) ScanWith(v interface{}, opts Options) error {
+	return (*Bool)(b).ScanWith(v, opts)
+}
+
+// MarshalJSON takes a Bool and marshal it in the JSON format of F
+func (b FormattedBool[F]) MarshalJSON() ([]byte, error) {
+	return Bool(b).marshalJSON(b.formats().JSON)
+}
+
+// UnmarshalJSON implement the un-Marshaler interface
+func (b *FormattedBool[F]) UnmarshalJSON(data []byte) error {
+	return (*Bool)(b).UnmarshalJSON(data)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but converts data by the rules
+// of opts
+func (b *FormattedBool[F]) UnmarshalJSONWith(data []byte, opts Options) error {
+	return (*Bool)(b).UnmarshalJSONWith(data, opts)
+}
+
+// MarshalText takes a Bool and marshal it in the Text format of F
+func (b FormattedBool[F]) MarshalText() ([]byte, error) {
+	return Bool(b).marshalText(b.formats().Text)
+}
+
+// UnmarshalText implement the text un-Marshaller interface.
+// An empty text, "null" and "nil" are all considered as nil.
+func (b *FormattedBool[F]) UnmarshalText(data []byte) error {
+	return (*Bool)(b).UnmarshalText(data)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but converts data by the rules
+// of opts
+func (b *FormattedBool[F]) UnmarshalTextWith(data []byte, opts Options) error {
+	return (*Bool)(b).UnmarshalTextWith(data, opts)
+}
@@ -0,0 +1,192 @@
+package extratypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestBoolFormatMarshal(t *testing.T) {
+	type toCheck = struct {
+		f        BoolFormat
+		val      bool
+		json     []byte
+		text     []byte
+		sqlValue interface{}
+	}
+
+	checks := []toCheck{
+		toCheck{f: BoolFormatTrueFalse, val: true, json: []byte(`true`), text: []byte(`true`), sqlValue: true},
+		toCheck{f: BoolFormatTrueFalse, val: false, json: []byte(`false`), text: []byte(`false`), sqlValue: false},
+		toCheck{f: BoolFormatYN, val: true, json: []byte(`"Y"`), text: []byte(`Y`), sqlValue: "Y"},
+		toCheck{f: BoolFormatYN, val: false, json: []byte(`"N"`), text: []byte(`N`), sqlValue: "N"},
+		toCheck{f: BoolFormatInt, val: true, json: []byte(`1`), text: []byte(`1`), sqlValue: int64(1)},
+		toCheck{f: BoolFormatInt, val: false, json: []byte(`0`), text: []byte(`0`), sqlValue: int64(0)},
+		toCheck{f: BoolFormatYesNo, val: true, json: []byte(`"yes"`), text: []byte(`yes`), sqlValue: "yes"},
+		toCheck{f: BoolFormatYesNo, val: false, json: []byte(`"no"`), text: []byte(`no`), sqlValue: "no"},
+	}
+
+	for _, check := range checks {
+		b := Bool{Val: check.val}
+
+		j, err := b.marshalJSON(check.f)
+		if err != nil || !bytes.Equal(j, check.json) {
+			t.Errorf("%d: json %s is not %s: %v", check.f, j, check.json, err)
+		}
+
+		text, err := b.marshalText(check.f)
+		if err != nil || !bytes.Equal(text, check.text) {
+			t.Errorf("%d: text %s is not %s: %v", check.f, text, check.text, err)
+		}
+
+		v, err := b.value(check.f)
+		if err != nil || v != check.sqlValue {
+			t.Errorf("%d: value (%T) %v is not (%T) %v: %v", check.f, v, v, check.sqlValue, check.sqlValue, err)
+		}
+
+		var result Bool
+		err = result.UnmarshalJSON(check.json)
+		if err != nil || result.Val != check.val || result.Nil {
+			t.Errorf("%d: json %s gave %+v: %v", check.f, check.json, result, err)
+		}
+
+		err = result.UnmarshalText(check.text)
+		if err != nil || result.Val != check.val || result.Nil {
+			t.Errorf("%d: text %s gave %+v: %v", check.f, check.text, result, err)
+		}
+
+		err = result.ScanWith(check.sqlValue, Options{Strict: true})
+		if err != nil || result.Val != check.val || result.Nil {
+			t.Errorf("%d: sql %v gave %+v: %v", check.f, check.sqlValue, result, err)
+		}
+	}
+
+	nilBool := Bool{Nil: true}
+	j, err := nilBool.marshalJSON(BoolFormatYN)
+	if err != nil || !bytes.Equal(j, []byte(`null`)) {
+		t.Errorf("json %s is not null: %v", j, err)
+	}
+
+	v, err := nilBool.value(BoolFormatInt)
+	if err != nil || v != nil {
+		t.Errorf("v %v is not nil: %v", v, err)
+	}
+}
+
+func TestBoolFormatGlobal(t *testing.T) {
+	BoolJSONFormat = BoolFormatInt
+	BoolSQLFormat = BoolFormatYN
+	defer func() {
+		BoolJSONFormat = BoolFormatTrueFalse
+		BoolSQLFormat = BoolFormatTrueFalse
+	}()
+
+	b := Bool{Val: true}
+	j, err := b.MarshalJSON()
+	if err != nil || !bytes.Equal(j, []byte(`1`)) {
+		t.Errorf("j %s is not 1: %v", j, err)
+	}
+
+	v, err := b.Value()
+	if err != nil || v != "Y" {
+		t.Errorf("v %v is not Y: %v", v, err)
+	}
+
+	text, err := b.MarshalText()
+	if err != nil || !bytes.Equal(text, []byte(`true`)) {
+		t.Errorf("text %s is not true: %v", text, err)
+	}
+}
+
+func TestFormattedBool(t *testing.T) {
+	type account struct {
+		Active  BoolYN    `json:"active"`
+		Admin   BoolInt   `json:"admin"`
+		Mailing BoolYesNo `json:"mailing"`
+	}
+
+	a := account{
+		Active:  BoolYN{Val: true},
+		Admin:   BoolInt{Val: false},
+		Mailing: BoolYesNo{Nil: true},
+	}
+
+	b, err := json.Marshal(a)
+	expected := []byte(`{"active":"Y","admin":0,"mailing":null}`)
+	if err != nil || !bytes.Equal(b, expected) {
+		t.Errorf("b %s is not %s: %v", b, expected, err)
+	}
+
+	var result account
+	err = json.Unmarshal(b, &result)
+	if err != nil || result != a {
+		t.Errorf("result %+v is not %+v: %v", result, a, err)
+	}
+
+	v, err := a.Admin.Value()
+	if err != nil || v != int64(0) {
+		t.Errorf("v %v is not 0: %v", v, err)
+	}
+
+	err = result.Mailing.Scan("yes")
+	if err != nil || !result.Mailing.Val || result.Mailing.Nil {
+		t.Errorf("mailing %+v is not yes: %v", result.Mailing, err)
+	}
+
+	text, err := result.Mailing.MarshalText()
+	if err != nil || !bytes.Equal(text, []byte(`yes`)) {
+		t.Errorf("text %s is not yes: %v", text, err)
+	}
+
+	if !a.Active.Bool().And(result.Mailing.Bool()).IsTrue() {
+		t.Errorf("%s and %s is not true", a.Active, result.Mailing)
+	}
+}
+
+func TestBoolFormatReplacedVocabulary(t *testing.T) {
+	DefaultOptions.BoolVocabulary = NewBoolVocabulary([]string{"on"}, []string{"off"})
+	defer func() {
+		DefaultOptions.BoolVocabulary = nil
+	}()
+
+	formats := []BoolFormat{BoolFormatTrueFalse, BoolFormatYN, BoolFormatInt, BoolFormatYesNo}
+	for _, f := range formats {
+		for _, val := range []bool{true, false} {
+			v, err := Bool{Val: val}.value(f)
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", f, err)
+				continue
+			}
+
+			result := Bool{Val: !val}
+			err = result.ScanWith(v, Options{BoolVocabulary: DefaultOptions.BoolVocabulary, RejectUnknownBool: true})
+			if err != nil || result.Val != val || result.Nil {
+				t.Errorf("%d: sql %v gave %+v, expected %t: %v", f, v, result, val, err)
+			}
+
+			text, err := Bool{Val: val}.marshalText(f)
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", f, err)
+				continue
+			}
+
+			result = Bool{Val: !val}
+			err = result.UnmarshalText(text)
+			if err != nil || result.Val != val || result.Nil {
+				t.Errorf("%d: text %s gave %+v, expected %t: %v", f, text, result, val, err)
+			}
+		}
+	}
+
+	var yn BoolYN
+	err := yn.Scan("on")
+	if err != nil || !yn.Val {
+		t.Errorf("yn %+v is not on: %v", yn, err)
+	}
+
+	err = yn.ScanWith("maybe", Options{BoolVocabulary: DefaultOptions.BoolVocabulary, RejectUnknownBool: true})
+	if !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+	}
+}
@@ -0,0 +1,88 @@
+package extratypes
+
+// The results of the three-valued logic
+var (
+	boolTrue  = Bool{Val: true}
+	boolFalse = Bool{Val: false}
+	boolNil   = Bool{Nil: true}
+)
+
+// boolOf returns the Bool of v
+func boolOf(v bool) Bool {
+	if v {
+		return boolTrue
+	}
+
+	return boolFalse
+}
+
+// IsTrue reports whether b is true, and not nil
+func (b Bool) IsTrue() bool {
+	return !b.Nil && b.Val
+}
+
+// IsFalse reports whether b is false, and not nil
+func (b Bool) IsFalse() bool {
+	return !b.Nil && !b.Val
+}
+
+// IsTrueOrNil reports whether b is true or nil
+func (b Bool) IsTrueOrNil() bool {
+	return b.Nil || b.Val
+}
+
+// IsFalseOrNil reports whether b is false or nil
+func (b Bool) IsFalseOrNil() bool {
+	return b.Nil || !b.Val
+}
+
+// Not returns the negation of b, it is nil when b is nil.
+func (b Bool) Not() Bool {
+	if b.Nil {
+		return boolNil
+	}
+
+	return boolOf(!b.Val)
+}
+
+// And returns b AND c by the three-valued logic of SQL. It is false when
+// one of them is false, even if the other is nil.
+func (b Bool) And(c Bool) Bool {
+	switch {
+	case b.IsFalse() || c.IsFalse():
+		return boolFalse
+	case b.Nil || c.Nil:
+		return boolNil
+	}
+
+	return boolTrue
+}
+
+// Or returns b OR c by the three-valued logic of SQL. It is true when one
+// of them is true, even if the other is nil.
+func (b Bool) Or(c Bool) Bool {
+	switch {
+	case b.IsTrue() || c.IsTrue():
+		return boolTrue
+	case b.Nil || c.Nil:
+		return boolNil
+	}
+
+	return boolFalse
+}
+
+// Xor returns b XOR c by the three-valued logic of SQL. It is nil when one
+// of them is nil.
+func (b Bool) Xor(c Bool) Bool {
+	if b.Nil || c.Nil {
+		return boolNil
+	}
+
+	return boolOf(b.Val != c.Val)
+}
+
+// Implies returns b -> c, that is (NOT b) OR c. It is true when b is false
+// or c is true, even if the other is nil.
+func (b Bool) Implies(c Bool) Bool {
+	return b.Not().Or(c)
+}
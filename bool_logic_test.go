@@ -0,0 +1,81 @@
+package extratypes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoolLogic(t *testing.T) {
+	T, F, N := validBoolTrue, validBoolFalse, validBoolNil
+
+	type toCheck = struct {
+		name     string
+		result   Bool
+		expected Bool
+	}
+
+	checks := []toCheck{
+		toCheck{"NOT true", T.Not(), F},
+		toCheck{"NOT false", F.Not(), T},
+		toCheck{"NOT nil", N.Not(), N},
+
+		toCheck{"true AND true", T.And(T), T},
+		toCheck{"true AND false", T.And(F), F},
+		toCheck{"true AND nil", T.And(N), N},
+		toCheck{"false AND nil", F.And(N), F},
+		toCheck{"nil AND false", N.And(F), F},
+		toCheck{"nil AND nil", N.And(N), N},
+
+		toCheck{"false OR false", F.Or(F), F},
+		toCheck{"true OR false", T.Or(F), T},
+		toCheck{"false OR nil", F.Or(N), N},
+		toCheck{"nil OR true", N.Or(T), T},
+		toCheck{"true OR nil", T.Or(N), T},
+		toCheck{"nil OR nil", N.Or(N), N},
+
+		toCheck{"true XOR false", T.Xor(F), T},
+		toCheck{"true XOR true", T.Xor(T), F},
+		toCheck{"true XOR nil", T.Xor(N), N},
+		toCheck{"nil XOR false", N.Xor(F), N},
+
+		toCheck{"true -> false", T.Implies(F), F},
+		toCheck{"true -> true", T.Implies(T), T},
+		toCheck{"false -> nil", F.Implies(N), T},
+		toCheck{"nil -> true", N.Implies(T), T},
+		toCheck{"true -> nil", T.Implies(N), N},
+		toCheck{"nil -> false", N.Implies(F), N},
+
+		toCheck{"dirty nil", Bool{Val: true, Nil: true}.Not(), N},
+	}
+
+	for _, check := range checks {
+		if !reflect.DeepEqual(check.result, check.expected) {
+			t.Errorf("%s: %+v is not %+v", check.name, check.result, check.expected)
+		}
+	}
+}
+
+func TestBoolChecks(t *testing.T) {
+	type toCheck = struct {
+		b            Bool
+		isTrue       bool
+		isFalse      bool
+		isTrueOrNil  bool
+		isFalseOrNil bool
+	}
+
+	checks := []toCheck{
+		toCheck{b: validBoolTrue, isTrue: true, isFalse: false, isTrueOrNil: true, isFalseOrNil: false},
+		toCheck{b: validBoolFalse, isTrue: false, isFalse: true, isTrueOrNil: false, isFalseOrNil: true},
+		toCheck{b: validBoolNil, isTrue: false, isFalse: false, isTrueOrNil: true, isFalseOrNil: true},
+		toCheck{b: Bool{Val: true, Nil: true}, isTrue: false, isFalse: false, isTrueOrNil: true, isFalseOrNil: true},
+	}
+
+	for _, check := range checks {
+		if check.b.IsTrue() != check.isTrue || check.b.IsFalse() != check.isFalse ||
+			check.b.IsTrueOrNil() != check.isTrueOrNil || check.b.IsFalseOrNil() != check.isFalseOrNil {
+
+			t.Errorf("%+v: unexpected result of the checks", check.b)
+		}
+	}
+}
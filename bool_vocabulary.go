@@ -0,0 +1,68 @@
+package extratypes
+
+import "strings"
+
+// BoolVocabulary maps the words that a Bool is read from, to the bool that
+// they mean. The words are lower case, and a word is looked up without its
+// case and surrounding spaces.
+type BoolVocabulary map[string]bool
+
+// DefaultBoolVocabulary is the vocabulary that is used when
+// Options.BoolVocabulary is nil
+var DefaultBoolVocabulary = BoolVocabulary{
+	"true": true, "false": false,
+	"yes": true, "no": false,
+	"t": true, "f": false,
+	"y": true, "n": false,
+	"1": true, "0": false, "-1": false,
+}
+
+// NewBoolVocabulary creates a BoolVocabulary of trueWords and falseWords,
+// such as NewBoolVocabulary([]string{"on", "enabled"}, []string{"off",
+// "disabled"}).
+func NewBoolVocabulary(trueWords, falseWords []string) BoolVocabulary {
+	v := make(BoolVocabulary, len(trueWords)+len(falseWords))
+	for _, word := range trueWords {
+		v[normalizeBoolWord(word)] = true
+	}
+
+	for _, word := range falseWords {
+		v[normalizeBoolWord(word)] = false
+	}
+
+	return v
+}
+
+// With returns a copy of v that is extended by the words of other. A word
+// that is in both has the bool of other.
+func (v BoolVocabulary) With(other BoolVocabulary) BoolVocabulary {
+	result := make(BoolVocabulary, len(v)+len(other))
+	for word, b := range v {
+		result[word] = b
+	}
+
+	for word, b := range other {
+		result[normalizeBoolWord(word)] = b
+	}
+
+	return result
+}
+
+// Lookup returns the bool of word, and whether word is in v
+func (v BoolVocabulary) Lookup(word string) (bool, bool) {
+	b, ok := v[normalizeBoolWord(word)]
+	return b, ok
+}
+
+func normalizeBoolWord(word string) string {
+	return strings.ToLower(strings.TrimSpace(word))
+}
+
+// boolVocabulary returns the vocabulary of o
+func (o Options) boolVocabulary() BoolVocabulary {
+	if o.BoolVocabulary == nil {
+		return DefaultBoolVocabulary
+	}
+
+	return o.BoolVocabulary
+}
@@ -0,0 +1,136 @@
+package extratypes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBoolVocabulary(t *testing.T) {
+	v := NewBoolVocabulary([]string{"Oui", " ja ", "sí"}, []string{"non", "nein", "no"})
+
+	type toCheck = struct {
+		word     string
+		expected bool
+		found    bool
+	}
+
+	checks := []toCheck{
+		toCheck{word: "oui", expected: true, found: true},
+		toCheck{word: "OUI", expected: true, found: true},
+		toCheck{word: "Ja", expected: true, found: true},
+		toCheck{word: "Sí", expected: true, found: true},
+		toCheck{word: " nein", expected: false, found: true},
+		toCheck{word: "yes", expected: false, found: false},
+	}
+
+	for _, check := range checks {
+		b, found := v.Lookup(check.word)
+		if b != check.expected || found != check.found {
+			t.Errorf("%q: %t (%t) is not %t (%t)", check.word, b, found, check.expected, check.found)
+		}
+	}
+
+	extended := DefaultBoolVocabulary.With(BoolVocabulary{"on": true, "OFF": false, "n": true})
+	if b, found := extended.Lookup("off"); b || !found {
+		t.Errorf("off: %t (%t) is not false", b, found)
+	}
+
+	if b, _ := extended.Lookup("n"); !b {
+		t.Errorf("n is expected to be replaced by true")
+	}
+
+	if _, found := DefaultBoolVocabulary.Lookup("on"); found {
+		t.Errorf("DefaultBoolVocabulary is expected to be unchanged")
+	}
+}
+
+func TestBoolWithVocabulary(t *testing.T) {
+	opts := Options{
+		BoolVocabulary: DefaultBoolVocabulary.With(NewBoolVocabulary(
+			[]string{"on", "enabled"}, []string{"off", "disabled"})),
+	}
+
+	type toCheck = struct {
+		src      interface{}
+		expected Bool
+	}
+
+	checks := []toCheck{
+		toCheck{src: "on", expected: validBoolTrue},
+		toCheck{src: []byte("Enabled"), expected: validBoolTrue},
+		toCheck{src: "off", expected: validBoolFalse},
+		toCheck{src: "yes", expected: validBoolTrue},
+		toCheck{src: "maybe", expected: validBoolFalse},
+	}
+
+	for _, check := range checks {
+		var b Bool
+		err := b.ScanWith(check.src, opts)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %s", check.src, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(b, check.expected) {
+			t.Errorf("%v: %+v is not %+v", check.src, b, check.expected)
+		}
+	}
+
+	var b Bool
+	err := b.UnmarshalJSONWith([]byte(`"disabled"`), opts)
+	if err != nil || !reflect.DeepEqual(b, validBoolFalse) {
+		t.Errorf("b %+v is not false: %v", b, err)
+	}
+
+	err = b.UnmarshalTextWith([]byte("on"), opts)
+	if err != nil || !reflect.DeepEqual(b, validBoolTrue) {
+		t.Errorf("b %+v is not true: %v", b, err)
+	}
+
+	// the vocabulary replaces the default one, but the words that a
+	// BoolFormat writes are still read
+	opts = Options{BoolVocabulary: NewBoolVocabulary([]string{"oui"}, []string{"non"})}
+	err = b.ScanWith("t", opts)
+	if err != nil || !reflect.DeepEqual(b, validBoolFalse) {
+		t.Errorf("b %+v is not false: %v", b, err)
+	}
+
+	err = b.ScanWith("yes", opts)
+	if err != nil || !reflect.DeepEqual(b, validBoolTrue) {
+		t.Errorf("b %+v is not true: %v", b, err)
+	}
+}
+
+func TestBoolRejectUnknown(t *testing.T) {
+	opts := Options{RejectUnknownBool: true}
+
+	var b Bool
+	err := b.ScanWith("maybe", opts)
+	if !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+	}
+
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Target != reflect.TypeOf(true) {
+		t.Errorf("Expected ConversionError into bool, got '%v'", err)
+	}
+
+	err = b.UnmarshalJSONWith([]byte(`"treu"`), opts)
+	if !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+	}
+
+	err = b.ScanWith("yes", opts)
+	if err != nil || !reflect.DeepEqual(b, validBoolTrue) {
+		t.Errorf("b %+v is not true: %v", b, err)
+	}
+
+	DefaultOptions.RejectUnknownBool = true
+	defer func() { DefaultOptions.RejectUnknownBool = false }()
+
+	err = b.Scan("nope")
+	if !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+	}
+}
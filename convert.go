@@ -0,0 +1,128 @@
+package extratypes
+
+import "time"
+
+// Convert copies to dest the value in src, converting it by the rules of
+// DefaultOptions. It is the same conversion that the nullable types of the
+// package use, so src can be a number, a string, a []byte or a bool, for
+// example a value taken out of a map[string]interface{}.
+//
+// dest must be a pointer to a string, []byte, bool, time.Time, time.Duration, one of the
+// int, uint and float types, a type that is based on one of them (such as "type ID int64"),
+// or a type that has a converter registered by RegisterConverter.
+// If src is nil, isNil is true and dest remains as-is.
+// The returned error is a *ConversionError.
+func Convert(src, dest interface{}) (isNil bool, err error) {
+	return toType(src, dest)
+}
+
+// Convert is like the package level Convert, but uses the rules of o
+func (o Options) Convert(src, dest interface{}) (isNil bool, err error) {
+	return o.toType(src, dest)
+}
+
+// ConvertTo converts src into T by the rules of DefaultOptions.
+// If src is nil, isNil is true and val is the zero value of T.
+func ConvertTo[T any](src interface{}) (val T, isNil bool, err error) {
+	isNil, err = toType(src, &val)
+	return
+}
+
+// convertNotNil converts src into T, and returns an error with
+// ReasonNilNotAllowed if src is nil
+func convertNotNil[T any](src interface{}) (T, error) {
+	val, isNil, err := ConvertTo[T](src)
+	if err != nil {
+		return val, err
+	}
+
+	if isNil {
+		return val, newConversionError(src, targetType(&val), ReasonNilNotAllowed, nil)
+	}
+
+	return val, nil
+}
+
+// ToInt converts src into int, see Convert for the rules.
+// A nil src returns an error with ReasonNilNotAllowed, as do all the
+// other To functions.
+func ToInt(src interface{}) (int, error) {
+	return convertNotNil[int](src)
+}
+
+// ToInt8 converts src into int8, see Convert for the rules
+func ToInt8(src interface{}) (int8, error) {
+	return convertNotNil[int8](src)
+}
+
+// ToInt16 converts src into int16, see Convert for the rules
+func ToInt16(src interface{}) (int16, error) {
+	return convertNotNil[int16](src)
+}
+
+// ToInt32 converts src into int32, see Convert for the rules
+func ToInt32(src interface{}) (int32, error) {
+	return convertNotNil[int32](src)
+}
+
+// ToInt64 converts src into int64, see Convert for the rules
+func ToInt64(src interface{}) (int64, error) {
+	return convertNotNil[int64](src)
+}
+
+// ToUint converts src into uint, see Convert for the rules
+func ToUint(src interface{}) (uint, error) {
+	return convertNotNil[uint](src)
+}
+
+// ToUint8 converts src into uint8, see Convert for the rules
+func ToUint8(src interface{}) (uint8, error) {
+	return convertNotNil[uint8](src)
+}
+
+// ToUint16 converts src into uint16, see Convert for the rules
+func ToUint16(src interface{}) (uint16, error) {
+	return convertNotNil[uint16](src)
+}
+
+// ToUint32 converts src into uint32, see Convert for the rules
+func ToUint32(src interface{}) (uint32, error) {
+	return convertNotNil[uint32](src)
+}
+
+// ToUint64 converts src into uint64, see Convert for the rules
+func ToUint64(src interface{}) (uint64, error) {
+	return convertNotNil[uint64](src)
+}
+
+// ToFloat32 converts src into float32, see Convert for the rules
+func ToFloat32(src interface{}) (float32, error) {
+	return convertNotNil[float32](src)
+}
+
+// ToFloat64 converts src into float64, see Convert for the rules
+func ToFloat64(src interface{}) (float64, error) {
+	return convertNotNil[float64](src)
+}
+
+// ToBool converts src into bool, see Convert for the rules
+func ToBool(src interface{}) (bool, error) {
+	return convertNotNil[bool](src)
+}
+
+// ToTime converts src into time.Time, see Convert for the rules.
+// A string is parsed by TimeLayouts, and a number is a Unix epoch.
+func ToTime(src interface{}) (time.Time, error) {
+	return convertNotNil[time.Time](src)
+}
+
+// ToDuration converts src into time.Duration, see Convert for the rules.
+// A string is parsed as a Duration does, and a number is nanoseconds.
+func ToDuration(src interface{}) (time.Duration, error) {
+	return convertNotNil[time.Duration](src)
+}
+
+// ToString converts src into string, see Convert for the rules
+func ToString(src interface{}) (string, error) {
+	return convertNotNil[string](src)
+}
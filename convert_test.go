@@ -0,0 +1,192 @@
+package extratypes
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	payload := map[string]interface{}{
+		"id":     "42",
+		"count":  float64(7),
+		"active": "yes",
+		"name":   10,
+		"empty":  nil,
+	}
+
+	var id int64
+	isNil, err := Convert(payload["id"], &id)
+	if err != nil || isNil || id != 42 {
+		t.Errorf("id: %d, isNil: %t, err: %v", id, isNil, err)
+	}
+
+	var active bool
+	isNil, err = Convert(payload["active"], &active)
+	if err != nil || isNil || !active {
+		t.Errorf("active: %t, isNil: %t, err: %v", active, isNil, err)
+	}
+
+	empty := 5
+	isNil, err = Convert(payload["empty"], &empty)
+	if err != nil || !isNil || empty != 5 {
+		t.Errorf("empty: %d, isNil: %t, err: %v", empty, isNil, err)
+	}
+
+	var count uint8
+	isNil, err = Options{Strict: true}.Convert(payload["count"], &count)
+	if err != nil || isNil || count != 7 {
+		t.Errorf("count: %d, isNil: %t, err: %v", count, isNil, err)
+	}
+
+	var small int8
+	_, err = Options{Strict: true}.Convert(1000, &small)
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected '%s', got '%v'", ErrOverflow, err)
+	}
+}
+
+func TestConvertTo(t *testing.T) {
+	val, isNil, err := ConvertTo[uint16]("65535")
+	if err != nil || isNil || val != 65535 {
+		t.Errorf("val: %d, isNil: %t, err: %v", val, isNil, err)
+	}
+
+	s, isNil, err := ConvertTo[string](nil)
+	if err != nil || !isNil || s != "" {
+		t.Errorf("s: '%s', isNil: %t, err: %v", s, isNil, err)
+	}
+
+	_, _, err = ConvertTo[struct{}](1)
+	if !errors.Is(err, ErrDestUnsupported) {
+		t.Errorf("Expected '%s', got '%v'", ErrDestUnsupported, err)
+	}
+}
+
+func TestToFunctions(t *testing.T) {
+	type toCheck = struct {
+		name     string
+		fn       func() (interface{}, error)
+		expected interface{}
+	}
+
+	checks := []toCheck{
+		toCheck{"ToInt", func() (interface{}, error) { return ToInt("-1") }, int(-1)},
+		toCheck{"ToInt8", func() (interface{}, error) { return ToInt8(1000) }, int8(127)},
+		toCheck{"ToInt16", func() (interface{}, error) { return ToInt16(1.9) }, int16(1)},
+		toCheck{"ToInt32", func() (interface{}, error) { return ToInt32([]byte("32")) }, int32(32)},
+		toCheck{"ToInt64", func() (interface{}, error) { return ToInt64(uint8(64)) }, int64(64)},
+		toCheck{"ToUint", func() (interface{}, error) { return ToUint(-1) }, uint(0)},
+		toCheck{"ToUint8", func() (interface{}, error) { return ToUint8("300") }, uint8(255)},
+		toCheck{"ToUint16", func() (interface{}, error) { return ToUint16(16) }, uint16(16)},
+		toCheck{"ToUint32", func() (interface{}, error) { return ToUint32("32") }, uint32(32)},
+		toCheck{"ToUint64", func() (interface{}, error) { return ToUint64(64.0) }, uint64(64)},
+		toCheck{"ToFloat32", func() (interface{}, error) { return ToFloat32("1.5") }, float32(1.5)},
+		toCheck{"ToFloat64", func() (interface{}, error) { return ToFloat64(int64(3)) }, float64(3)},
+		toCheck{"ToBool", func() (interface{}, error) { return ToBool("t") }, true},
+		toCheck{"ToString", func() (interface{}, error) { return ToString(1.5) }, "1.5"},
+	}
+
+	for _, check := range checks {
+		val, err := check.fn()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.name, err)
+			continue
+		}
+
+		if val != check.expected {
+			t.Errorf("%s: (%T) %v is not (%T) %v", check.name, val, val, check.expected, check.expected)
+		}
+	}
+}
+
+func TestToTime(t *testing.T) {
+	tm, err := ToTime("2020-05-17 10:30:00")
+	if err != nil || tm.Unix() != 1589711400 {
+		t.Errorf("tm: %s, err: %v", tm, err)
+	}
+
+	tm, err = ToTime(1589711400000)
+	if err != nil || tm.Unix() != 1589711400 {
+		t.Errorf("tm: %s, err: %v", tm, err)
+	}
+}
+
+func TestToStringUnsupported(t *testing.T) {
+	type toCheck = struct {
+		src interface{}
+	}
+
+	checks := []toCheck{
+		toCheck{src: map[string]interface{}{"a": 1}},
+		toCheck{src: []interface{}{1, 2}},
+		toCheck{src: []int{1}},
+		toCheck{src: [2]string{"a", "b"}},
+		toCheck{src: struct{ A int }{1}},
+		toCheck{src: &struct{}{}},
+	}
+
+	for _, check := range checks {
+		_, err := ToString(check.src)
+		if !errors.Is(err, ErrUnsupported) {
+			t.Errorf("%#v: expected '%s', got '%v'", check.src, ErrUnsupported, err)
+		}
+	}
+
+	s, err := ToString(json.RawMessage(`{"a":1}`))
+	if err != nil || s != `{"a":1}` {
+		t.Errorf("s %s is not {\"a\":1}: %v", s, err)
+	}
+}
+
+func TestToDuration(t *testing.T) {
+	d, err := ToDuration("1h30m")
+	if err != nil || d != 90*time.Minute {
+		t.Errorf("d: %s, err: %v", d, err)
+	}
+
+	d, err = ToDuration([]byte("PT2S"))
+	if err != nil || d != 2*time.Second {
+		t.Errorf("d: %s, err: %v", d, err)
+	}
+
+	d, err = ToDuration(int64(1500))
+	if err != nil || d != 1500 {
+		t.Errorf("d: %s, err: %v", d, err)
+	}
+
+	d, err = ToDuration("5")
+	if err != nil || d != 5 {
+		t.Errorf("d: %s, err: %v", d, err)
+	}
+
+	var dur time.Duration
+	_, err = Options{Strict: true}.Convert("soon", &dur)
+	if !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+	}
+
+	var n Null[time.Duration]
+	err = n.Scan("5")
+	if err != nil || n.Val != 5 {
+		t.Errorf("n: %+v, err: %v", n, err)
+	}
+}
+
+func TestToFunctionsNil(t *testing.T) {
+	_, err := ToInt64(nil)
+	if !errors.Is(err, ErrNilNotAllowed) {
+		t.Errorf("Expected '%s', got '%v'", ErrNilNotAllowed, err)
+	}
+
+	_, err = ToTime(nil)
+	if !errors.Is(err, ErrNilNotAllowed) {
+		t.Errorf("Expected '%s', got '%v'", ErrNilNotAllowed, err)
+	}
+
+	_, err = ToString(nil)
+	if !errors.Is(err, ErrNilNotAllowed) {
+		t.Errorf("Expected '%s', got '%v'", ErrNilNotAllowed, err)
+	}
+}
@@ -0,0 +1,205 @@
+package extratypes
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Date is a calendar date without a time of the day and a time zone, such
+// as an SQL DATE column, that can be null.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+	Nil   bool
+}
+
+// DateLayout is the layout that Date is written with on JSON, Text and SQL
+const DateLayout = "2006-01-02"
+
+var dateType = reflect.TypeOf(Date{})
+
+// NewDate returns the Date of year, month and day. Values out of their
+// range are normalized the same way as time.Date does, so 31 of April is
+// 1 of May.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the Date of t, in the location of t
+func DateOf(t time.Time) Date {
+	var d Date
+	d.Year, d.Month, d.Day = t.Date()
+	return d
+}
+
+// In returns the midnight that starts d in loc
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// At returns the time of the day t at d in loc
+func (d Date) At(t TimeOfDay, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day,
+		t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// Weekday returns the day of the week of d
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// AddDate returns d with years, months and days added to it. A nil Date
+// stays nil.
+func (d Date) AddDate(years, months, days int) Date {
+	if d.Nil {
+		return d
+	}
+
+	return DateOf(d.In(time.UTC).AddDate(years, months, days))
+}
+
+// AddDays returns d with days added to it. A nil Date stays nil.
+func (d Date) AddDays(days int) Date {
+	return d.AddDate(0, 0, days)
+}
+
+// Sub returns the number of days between u and d, it is 0 when one of
+// them is nil.
+func (d Date) Sub(u Date) int {
+	if d.Nil || u.Nil {
+		return 0
+	}
+
+	return int(d.days() - u.days())
+}
+
+// Compare returns -1 when d is before u, 1 when d is after u and 0 when
+// they are equal. A nil Date is before any other Date.
+func (d Date) Compare(u Date) int {
+	switch {
+	case d.Nil && u.Nil:
+		return 0
+	case d.Nil:
+		return -1
+	case u.Nil:
+		return 1
+	}
+
+	days := d.days() - u.days()
+	switch {
+	case days < 0:
+		return -1
+	case days > 0:
+		return 1
+	}
+
+	return 0
+}
+
+// Before reports whether d is before u
+func (d Date) Before(u Date) bool {
+	return d.Compare(u) < 0
+}
+
+// After reports whether d is after u
+func (d Date) After(u Date) bool {
+	return d.Compare(u) > 0
+}
+
+// Equal reports whether d and u are the same date, or both nil
+func (d Date) Equal(u Date) bool {
+	return d.Compare(u) == 0
+}
+
+// days returns the number of days since the Unix epoch
+func (d Date) days() int64 {
+	return d.In(time.UTC).Unix() / int64(24*time.Hour/time.Second)
+}
+
+func (d Date) String() string {
+	if d.Nil {
+		return "nil"
+	}
+
+	return d.In(time.UTC).Format(DateLayout)
+}
+
+// Value implements the driver Valuer interface.
+func (d Date) Value() (driver.Value, error) {
+	if d.Nil {
+		return nil, nil
+	}
+
+	return d.String(), nil
+}
+
+// Scan implements the Scanner interface. A time.Time gives its date in
+// its own location, so it is not shifted by the time zone.
+func (d *Date) Scan(v interface{}) error {
+	var s string
+	switch val := v.(type) {
+	case nil:
+		*d = Date{Nil: true}
+		return nil
+	case time.Time:
+		*d = DateOf(val)
+		return nil
+	case string:
+		s = val
+	case []byte:
+		s = string(val)
+	default:
+		return newConversionError(v, dateType, ReasonUnsupported, nil)
+	}
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return d.Scan(nil)
+	}
+
+	t, err := parseLayouts(s, append([]string{DateLayout}, TimeLayouts...))
+	if err != nil {
+		return newConversionError(v, dateType, ReasonSyntax, err)
+	}
+
+	*d = DateOf(t)
+	return nil
+}
+
+// MarshalJSON takes a Date and marshal it as a string in DateLayout
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.Nil {
+		return json.Marshal(nil)
+	}
+
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON takes a string or null and converts it to Date
+func (d *Date) UnmarshalJSON(b []byte) error {
+	v, err := decodeJSON(b)
+	if err != nil {
+		return newConversionError(b, dateType, ReasonSyntax, err)
+	}
+
+	return d.Scan(fromJSONNumber(v))
+}
+
+// MarshalText takes a Date and marshal it as a string in DateLayout
+func (d Date) MarshalText() ([]byte, error) {
+	if d.Nil {
+		return []byte(""), nil
+	}
+
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText takes a string and converts it to Date. An empty text is
+// nil.
+func (d *Date) UnmarshalText(b []byte) error {
+	return d.Scan(string(b))
+}
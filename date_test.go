@@ -0,0 +1,253 @@
+package extratypes
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validDate = Date{
+		Year:  2020,
+		Month: time.May,
+		Day:   17,
+		Nil:   false,
+	}
+
+	nilDate = Date{
+		Nil: true,
+	}
+)
+
+var (
+	testDateJSON      = []byte(`"2020-05-17"`)
+	testDateNilJSON   = []byte(`null`)
+	testDateValidText = []byte(`2020-05-17`)
+	testDateNilText   = []byte(``)
+)
+
+func TestDateString(t *testing.T) {
+	if validDate.String() != string(testDateValidText) {
+		t.Errorf("d [%s] is not %s", validDate, testDateValidText)
+	}
+
+	if nilDate.String() != "nil" {
+		t.Errorf("d [%s] is not nil", nilDate)
+	}
+}
+
+func TestDateScan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		loc := time.FixedZone("UTC-5", -5*60*60)
+		rows := mock.NewRows([]string{"d"}).
+			AddRow(time.Date(2020, time.May, 17, 0, 0, 0, 0, time.UTC)).
+			AddRow(time.Date(2020, time.May, 17, 23, 30, 0, 0, loc)).
+			AddRow("2020-05-17").
+			AddRow([]byte("2020-05-17")).
+			AddRow("2020-05-17T23:30:00-05:00")
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var d Date
+			err := rs.Scan(&d)
+			if err != nil {
+				te.Errorf("Unable to scan Date: %s", err)
+			}
+			if !reflect.DeepEqual(d, validDate) {
+				te.Errorf("d %+v is not %+v", d, validDate)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"d"}).
+			AddRow(nil).
+			AddRow("")
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			d := validDate
+			err := rs.Scan(&d)
+			if err != nil {
+				te.Errorf("Unable to scan Date: %s", err)
+			}
+			if !reflect.DeepEqual(d, nilDate) {
+				te.Errorf("d %+v expected to be nil", d)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test invalid", func(te *testing.T) {
+		var d Date
+		err := d.Scan("2020-13-01")
+		if !errors.Is(err, ErrSyntax) {
+			te.Errorf("Expected '%s', got %v", ErrSyntax, err)
+		}
+
+		err = d.Scan(20200517)
+		if !errors.Is(err, ErrUnsupported) {
+			te.Errorf("Expected '%s', got %v", ErrUnsupported, err)
+		}
+	})
+}
+
+func TestDateValue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs("2020-05-17", nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (d)", validDate, nilDate)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestDateArithmetic(t *testing.T) {
+	if d := NewDate(2020, time.April, 31); !reflect.DeepEqual(d, NewDate(2020, time.May, 1)) {
+		t.Errorf("d %s is not normalized", d)
+	}
+
+	type toCheck = struct {
+		d        Date
+		expected Date
+	}
+
+	checks := []toCheck{
+		toCheck{d: validDate.AddDays(15), expected: NewDate(2020, time.June, 1)},
+		toCheck{d: validDate.AddDays(-17), expected: NewDate(2020, time.April, 30)},
+		toCheck{d: validDate.AddDate(1, 1, 0), expected: NewDate(2021, time.June, 17)},
+		toCheck{d: nilDate.AddDays(1), expected: nilDate},
+	}
+
+	for _, check := range checks {
+		if !reflect.DeepEqual(check.d, check.expected) {
+			t.Errorf("d %s is not %s", check.d, check.expected)
+		}
+	}
+
+	if days := NewDate(2021, time.May, 17).Sub(validDate); days != 365 {
+		t.Errorf("days %d is not 365", days)
+	}
+
+	if days := NewDate(1969, time.December, 31).Sub(NewDate(1970, time.January, 2)); days != -2 {
+		t.Errorf("days %d is not -2", days)
+	}
+
+	if validDate.Weekday() != time.Sunday {
+		t.Errorf("weekday %s is not Sunday", validDate.Weekday())
+	}
+
+	tm := validDate.At(NewTimeOfDay(10, 30, 0, 0), time.UTC)
+	if !tm.Equal(validTime.Time) {
+		t.Errorf("tm %s is not %s", tm, validTime.Time)
+	}
+}
+
+func TestDateCompare(t *testing.T) {
+	next := validDate.AddDays(1)
+
+	if !validDate.Before(next) || validDate.After(next) || !next.After(validDate) {
+		t.Errorf("%s is expected to be before %s", validDate, next)
+	}
+
+	if !validDate.Equal(NewDate(2020, time.May, 17)) {
+		t.Errorf("%s is expected to be equal", validDate)
+	}
+
+	if !nilDate.Before(validDate) || !nilDate.Equal(Date{Nil: true}) {
+		t.Errorf("nil is expected to be before any date")
+	}
+}
+
+func TestDateJSON(t *testing.T) {
+	result, err := validDate.MarshalJSON()
+	if err != nil || !bytes.Equal(result, testDateJSON) {
+		t.Errorf("Expected '%s', got '%s': %v", testDateJSON, result, err)
+	}
+
+	result, err = nilDate.MarshalJSON()
+	if err != nil || !bytes.Equal(result, testDateNilJSON) {
+		t.Errorf("Expected '%s', got '%s': %v", testDateNilJSON, result, err)
+	}
+
+	type toCheck = struct {
+		b        []byte
+		expected Date
+	}
+
+	checks := []toCheck{
+		toCheck{b: testDateJSON, expected: validDate},
+		toCheck{b: []byte(`"2020-05-17T10:30:00Z"`), expected: validDate},
+		toCheck{b: testDateNilJSON, expected: nilDate},
+	}
+
+	for _, check := range checks {
+		var d Date
+		err := d.UnmarshalJSON(check.b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.b, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(d, check.expected) {
+			t.Errorf("%s: result %+v not equal to %+v", check.b, d, check.expected)
+		}
+	}
+
+	var d Date
+	if err := d.UnmarshalJSON([]byte("a")); err == nil {
+		t.Errorf("expected error, but none given")
+	}
+}
+
+func TestDateText(t *testing.T) {
+	b, err := validDate.MarshalText()
+	if err != nil || !bytes.Equal(b, testDateValidText) {
+		t.Errorf("b %s is not %s: %v", b, testDateValidText, err)
+	}
+
+	b, err = nilDate.MarshalText()
+	if err != nil || !bytes.Equal(b, testDateNilText) {
+		t.Errorf("b %s is not %s: %v", b, testDateNilText, err)
+	}
+
+	var d Date
+	err = d.UnmarshalText(testDateValidText)
+	if err != nil || !reflect.DeepEqual(d, validDate) {
+		t.Errorf("d %+v is not %+v: %v", d, validDate, err)
+	}
+
+	err = d.UnmarshalText(testDateNilText)
+	if err != nil || !reflect.DeepEqual(d, nilDate) {
+		t.Errorf("d %+v expected to be nil: %v", d, err)
+	}
+}
@@ -15,163 +15,236 @@ type Duration struct {
 	Nil bool
 }
 
-// Value that the database usage will see
+var durationType = reflect.TypeOf(Duration{})
+
+// parseDuration parses s as an ISO 8601 duration when it starts with P,
+// or by time.ParseDuration otherwise. If time.ParseDuration fails, s is
+// parsed as a human duration that may have days, weeks and long unit
+// names, such as "1 day 4 hours", and then as a PostgreSQL INTERVAL or a
+// MySQL TIME, such as "1 day 02:03:04" or "838:59:59".
+func parseDuration(s string) (time.Duration, error) {
+	if isISO8601Duration(s) {
+		return parseISO8601Duration(s)
+	}
+
+	d, err := time.ParseDuration(s)
+	if err == nil {
+		return d, nil
+	}
+
+	if hd, herr := parseHumanDuration(s); herr == nil {
+		return hd, nil
+	}
+
+	if id, ierr := parseInterval(s); ierr == nil {
+		return id, nil
+	}
+
+	return 0, err
+}
+
+// setNil marks d as nil
+func (d *Duration) setNil() {
+	d.Duration = -1
+	d.Nil = true
+}
+
+// Value that the database usage will see, in DurationSQLFormat
 func (d Duration) Value() (driver.Value, error) {
-	return int64(d.Duration), nil
+	return d.value(DurationSQLFormat)
+}
+
+func (d Duration) value(f DurationFormat) (driver.Value, error) {
+	// Nanoseconds are written as they are, so nil is kept as -1
+	if f == DurationNanoseconds {
+		return int64(d.Duration), nil
+	}
+
+	if d.Nil {
+		return nil, nil
+	}
+
+	if f == DurationObject {
+		return f.format(d.Duration), nil
+	}
+
+	return f.value(d.Duration), nil
 }
 
-// Scan the result from a query and assign it to the struct
+// Scan the result from a query and assign it to the struct. A number is in
+// the unit of DurationSQLFormat.
 func (d *Duration) Scan(v interface{}) error {
-	if v == nil {
-		d.Duration = -1
-		d.Nil = true
-		return nil
+	return d.scan(v, DurationSQLFormat)
+}
+
+func (d *Duration) scan(v interface{}, f DurationFormat) error {
+	if b, ok := v.([]byte); ok {
+		v = string(b)
 	}
 
 	val := reflect.ValueOf(v)
 	kind := val.Kind()
 
+	var dur time.Duration
+	var err error
 	switch kind {
-	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		d.Duration = time.Duration(val.Int())
-		if d.Duration == -1 {
-			d.Nil = true
-		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		dur, err = f.fromInt(val.Int())
 	case reflect.String:
-		var err error
 		str := val.String()
 		if str == "" {
-			d.Duration = -1
-			d.Nil = true
+			d.setNil()
 			return nil
 		}
 
-		d.Duration, err = time.ParseDuration(str)
-		if err != nil {
-			return err
-		}
-
+		dur, err = f.parse(str)
 	case reflect.Float32, reflect.Float64:
-		f := val.Float()
-		if f == 0 || f < 0 {
-			d.Duration = -1
-			d.Nil = true
+		// a float that is not positive is nil only in nanoseconds, as it
+		// was before there were formats, since other formats write 0
+		// and negative values as floats
+		fl := val.Float()
+		if f == DurationNanoseconds && fl <= 0 {
+			d.setNil()
 			return nil
 		}
-		d.Duration = time.Duration(f)
+		dur, err = f.fromFloat(fl)
 	case reflect.Invalid:
-		d.Duration = -1
-		d.Nil = true
+		d.setNil()
 		return nil
 	default:
-		return fmt.Errorf("Invalid type of %T", val)
+		return newConversionError(v, durationType, ReasonUnsupported, nil)
 	}
 
+	if err != nil {
+		return asConversionError(v, durationType, ReasonSyntax, err)
+	}
+
+	d.Duration = dur
+	// nanoseconds writes a nil Duration as -1
+	d.Nil = f == DurationNanoseconds && dur == -1
 	return nil
 }
 
-// MarshalJSON takes a duration and marshal it as a string
+// MarshalJSON takes a duration and marshal it in DurationJSONFormat
 func (d Duration) MarshalJSON() ([]byte, error) {
+	return d.marshalJSON(DurationJSONFormat)
+}
+
+func (d Duration) marshalJSON(f DurationFormat) ([]byte, error) {
 	if d.Nil {
 		return json.Marshal(nil)
 	}
-	return json.Marshal(d.String())
+	return json.Marshal(f.value(d.Duration))
 }
 
-// MarshalText takes duration and marshal it as a string
+// MarshalText takes duration and marshal it as a string in
+// DurationTextFormat
 func (d *Duration) MarshalText() ([]byte, error) {
+	return d.marshalText(DurationTextFormat)
+}
+
+func (d Duration) marshalText(f DurationFormat) ([]byte, error) {
 	if d.Nil {
 		return []byte(""), nil
 	}
-	return []byte(d.Duration.String()), nil
+	return []byte(f.format(d.Duration)), nil
 }
 
-// UnmarshalJSON takes a slice of bytes and convert it to Duration
+// UnmarshalJSON takes a slice of bytes and convert it to Duration. A
+// number is in the unit of DurationJSONFormat.
 func (d *Duration) UnmarshalJSON(b []byte) error {
-	var v interface{}
-	if err := json.Unmarshal(b, &v); err != nil {
-		return err
+	return d.unmarshalJSON(b, DurationJSONFormat)
+}
+
+func (d *Duration) unmarshalJSON(b []byte, f DurationFormat) error {
+	v, err := decodeJSON(b)
+	if err != nil {
+		return newConversionError(b, durationType, ReasonSyntax, err)
 	}
 
-	val := reflect.ValueOf(v)
-	kind := val.Kind()
+	v = fromJSONNumber(v)
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return d.fromJSONValue(v, f)
+	}
 
-	switch kind {
-	case reflect.Float32, reflect.Float64:
-		d.Duration = time.Duration(val.Float())
-		return nil
-	case reflect.String:
-		var err error
-		d.Duration, err = time.ParseDuration(val.String())
+	if f == DurationObject {
+		dur, err := durationFromObject(m)
 		if err != nil {
-			return err
+			return asConversionError(v, durationType, ReasonSyntax, err)
 		}
+
+		d.Duration = dur
+		d.Nil = false
 		return nil
-	case reflect.Map:
-		iface := val.Interface()
-		m, success := iface.(map[string]interface{})
-		if !success {
-			return errors.New("Invalid map type")
-		}
+	}
 
-		l := len(m)
-		if l == 0 {
-			return errors.New("no content found")
-		}
+	l := len(m)
+	if l == 0 {
+		return newConversionError(v, durationType, ReasonSyntax,
+			errors.New("no content found"))
+	}
 
-		if l > 1 {
-			return fmt.Errorf("Length %d is too big", l)
-		}
+	if l > 1 {
+		return newConversionError(v, durationType, ReasonSyntax,
+			fmt.Errorf("Length %d is too big", l))
+	}
 
-		var err error
-		for _, value := range m {
-			val2 := reflect.ValueOf(value)
-			kind2 := val2.Kind()
-			switch kind2 {
-			case reflect.Float32, reflect.Float64:
-				d.Duration = time.Duration(val2.Float())
-				return nil
-			case reflect.String:
-				d.Duration, err = time.ParseDuration(val2.String())
-				if err != nil {
-					return err
-				}
-				return nil
-			case reflect.Invalid:
-				d.Duration = -1
-				d.Nil = true
-				return nil
-
-			default:
-				return fmt.Errorf("Invalid kind of value: %s", kind2)
-			}
-		}
-	case reflect.Invalid:
-		d.Duration = -1
-		d.Nil = true
+	for _, value := range m {
+		return d.fromJSONValue(value, f)
+	}
+	return errors.New("Unknown error")
+}
+
+// fromJSONValue sets d from a decoded JSON value that is not an object
+func (d *Duration) fromJSONValue(v interface{}, f DurationFormat) error {
+	var dur time.Duration
+	var err error
+	switch val := v.(type) {
+	case nil:
+		d.setNil()
 		return nil
+	case int64:
+		dur, err = f.fromInt(val)
+	case uint64:
+		err = ErrOverflow
+	case float64:
+		dur, err = f.fromFloat(val)
+	case string:
+		dur, err = f.parse(val)
 	default:
-		return fmt.Errorf("Invalid duration type: %T, %+v", v, v)
+		return newConversionError(v, durationType, ReasonUnsupported, nil)
 	}
-	return errors.New("Unknown error")
+
+	if err != nil {
+		return asConversionError(v, durationType, ReasonSyntax, err)
+	}
+
+	d.Duration = dur
+	d.Nil = false
+	return nil
 }
 
-// UnmarshalText unmar
+// UnmarshalText takes a string and converts it to Duration. A number is in
+// the unit of DurationTextFormat.
 func (d *Duration) UnmarshalText(b []byte) error {
+	return d.unmarshalText(b, DurationTextFormat)
+}
+
+func (d *Duration) unmarshalText(b []byte, f DurationFormat) error {
 	if len(b) == 0 {
-		d.Duration = -1
-		d.Nil = true
+		d.setNil()
 		return nil
 	}
 
-	var err error
-	d.Duration, err = time.ParseDuration(string(b))
-
+	dur, err := f.parse(string(b))
 	if err != nil {
-		d.Duration = -1
-		d.Nil = true
-		return err
+		d.setNil()
+		return asConversionError(string(b), durationType, ReasonSyntax, err)
 	}
+
+	d.Duration = dur
+	d.Nil = false
 	return nil
 }
 
@@ -0,0 +1,349 @@
+package extratypes
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationFormat is the way that a Duration is written on JSON, Text and
+// SQL. A number is read in the unit of the format, and a string is read in
+// any of the string formats.
+type DurationFormat int
+
+// The available formats of a Duration
+const (
+	// DurationGo is the format of time.Duration, such as "1h30m0s"
+	DurationGo DurationFormat = iota
+	// DurationISO8601 is an ISO 8601 duration, such as "PT1H30M"
+	DurationISO8601
+	// DurationNanoseconds is an integer of nanoseconds
+	DurationNanoseconds
+	// DurationMilliseconds is an integer of milliseconds
+	DurationMilliseconds
+	// DurationSeconds is an integer of seconds
+	DurationSeconds
+	// DurationFloatSeconds is a number of seconds with a fraction, such as
+	// 1.5
+	DurationFloatSeconds
+	// DurationObject is an object of seconds and nanos, as the JSON of
+	// protobuf, such as {"seconds":1,"nanos":500000000}
+	DurationObject
+	// DurationInterval is a time of [-]HH:MM:SS[.frac], such as "36:00:00",
+	// that PostgreSQL reads as an INTERVAL and MySQL as a TIME
+	DurationInterval
+)
+
+var (
+	// DurationJSONFormat is the format of Duration on JSON
+	DurationJSONFormat = DurationGo
+	// DurationTextFormat is the format of Duration on Text
+	DurationTextFormat = DurationGo
+	// DurationSQLFormat is the format of Duration on SQL
+	DurationSQLFormat = DurationNanoseconds
+)
+
+// durationObject is a Duration in DurationObject
+type durationObject struct {
+	Seconds int64 `json:"seconds"`
+	Nanos   int32 `json:"nanos"`
+}
+
+// unit returns the unit of a number in f
+func (f DurationFormat) unit() time.Duration {
+	switch f {
+	case DurationMilliseconds:
+		return time.Millisecond
+	case DurationSeconds, DurationFloatSeconds, DurationObject:
+		return time.Second
+	}
+
+	return time.Nanosecond
+}
+
+// value returns d in f, as it is written to JSON
+func (f DurationFormat) value(d time.Duration) interface{} {
+	switch f {
+	case DurationISO8601:
+		return formatISO8601Duration(d)
+	case DurationInterval:
+		return formatInterval(d)
+	case DurationNanoseconds:
+		return int64(d)
+	case DurationMilliseconds:
+		return d.Milliseconds()
+	case DurationSeconds:
+		return int64(d / time.Second)
+	case DurationFloatSeconds:
+		return d.Seconds()
+	case DurationObject:
+		return durationObject{
+			Seconds: int64(d / time.Second),
+			Nanos:   int32(d % time.Second),
+		}
+	}
+
+	return d.String()
+}
+
+// format returns d as a string in f
+func (f DurationFormat) format(d time.Duration) string {
+	switch v := f.value(d).(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case durationObject:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+
+	return d.String()
+}
+
+// fromInt returns n units of f
+func (f DurationFormat) fromInt(n int64) (time.Duration, error) {
+	unit := int64(f.unit())
+	if n > math.MaxInt64/unit || n < math.MinInt64/unit {
+		return 0, ErrOverflow
+	}
+
+	return time.Duration(n * unit), nil
+}
+
+// fromFloat returns n units of f, rounded to a nanosecond
+func (f DurationFormat) fromFloat(n float64) (time.Duration, error) {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, ErrSyntax
+	}
+
+	v := math.Round(n * float64(f.unit()))
+	if v >= math.MaxInt64 || v < math.MinInt64 {
+		return 0, ErrOverflow
+	}
+
+	return time.Duration(v), nil
+}
+
+// isNumeric reports whether the values of f are numbers
+func (f DurationFormat) isNumeric() bool {
+	return f != DurationGo && f != DurationISO8601 && f != DurationInterval
+}
+
+// parse parses s in f. If f is numeric, a number is units of f, and for
+// DurationObject a JSON object is read as well. Any other string is parsed
+// by parseDuration.
+func (f DurationFormat) parse(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+
+	if f.isNumeric() {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return f.fromInt(n)
+		}
+
+		if n, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(n) && !math.IsInf(n, 0) {
+			return f.fromFloat(n)
+		}
+	}
+
+	if f == DurationObject && strings.HasPrefix(s, "{") {
+		v, err := decodeJSON([]byte(s))
+		if err != nil {
+			return 0, err
+		}
+
+		m, ok := fromJSONNumber(v).(map[string]interface{})
+		if !ok {
+			return 0, ErrSyntax
+		}
+
+		return durationFromObject(m)
+	}
+
+	return parseDuration(s)
+}
+
+// durationFromObject returns the Duration of an object of seconds and
+// nanos. They can be numbers or strings, as protobuf writes an int64 as a
+// string.
+func durationFromObject(m map[string]interface{}) (time.Duration, error) {
+	var d time.Duration
+	for key, value := range m {
+		var unit DurationFormat
+		switch key {
+		case "seconds":
+			unit = DurationSeconds
+		case "nanos":
+			unit = DurationNanoseconds
+		default:
+			return 0, ErrSyntax
+		}
+
+		var part time.Duration
+		var err error
+		switch v := value.(type) {
+		case int64:
+			part, err = unit.fromInt(v)
+		case float64:
+			part, err = unit.fromFloat(v)
+		case string:
+			var n int64
+			n, err = strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return 0, strconvErr(err)
+			}
+			part, err = unit.fromInt(n)
+		default:
+			return 0, ErrSyntax
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		d, err = addDuration(d, part)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return d, nil
+}
+
+// DurationFormats are the formats of a FormattedDuration
+type DurationFormats struct {
+	JSON DurationFormat
+	Text DurationFormat
+	SQL  DurationFormat
+}
+
+// DurationFormatter selects the formats of a FormattedDuration. It is
+// implemented by an empty struct, that is used only as a type parameter:
+//
+//	type apiDuration struct{}
+//
+//	func (apiDuration) DurationFormats() extratypes.DurationFormats {
+//		return extratypes.DurationFormats{
+//			JSON: extratypes.DurationMilliseconds,
+//			Text: extratypes.DurationGo,
+//			SQL:  extratypes.DurationSeconds,
+//		}
+//	}
+//
+//	type Job struct {
+//		Timeout extratypes.FormattedDuration[apiDuration]
+//	}
+type DurationFormatter interface {
+	DurationFormats() DurationFormats
+}
+
+// FormattedDuration is a Duration that is written and read in the formats
+// of F, instead of DurationJSONFormat, DurationTextFormat and
+// DurationSQLFormat, so each field can have its own formats.
+type FormattedDuration[F DurationFormatter] Duration
+
+// The formats that are used on JSON, Text and SQL at once
+type (
+	inGo           struct{}
+	inISO8601      struct{}
+	inNanoseconds  struct{}
+	inMilliseconds struct{}
+	inSeconds      struct{}
+	inFloatSeconds struct{}
+	inObject       struct{}
+	inInterval     struct{}
+)
+
+func (inGo) DurationFormats() DurationFormats {
+	return sameDurationFormats(DurationGo)
+}
+
+func (inISO8601) DurationFormats() DurationFormats {
+	return sameDurationFormats(DurationISO8601)
+}
+
+func (inNanoseconds) DurationFormats() DurationFormats {
+	return sameDurationFormats(DurationNanoseconds)
+}
+
+func (inMilliseconds) DurationFormats() DurationFormats {
+	return sameDurationFormats(DurationMilliseconds)
+}
+
+func (inSeconds) DurationFormats() DurationFormats {
+	return sameDurationFormats(DurationSeconds)
+}
+
+func (inFloatSeconds) DurationFormats() DurationFormats {
+	return sameDurationFormats(DurationFloatSeconds)
+}
+
+func (inObject) DurationFormats() DurationFormats {
+	return sameDurationFormats(DurationObject)
+}
+
+func (inInterval) DurationFormats() DurationFormats {
+	return sameDurationFormats(DurationInterval)
+}
+
+func sameDurationFormats(f DurationFormat) DurationFormats {
+	return DurationFormats{JSON: f, Text: f, SQL: f}
+}
+
+// Durations that use a single format on JSON, Text and SQL
+type (
+	GoDuration           = FormattedDuration[inGo]
+	ISO8601Duration      = FormattedDuration[inISO8601]
+	NanosecondsDuration  = FormattedDuration[inNanoseconds]
+	MillisecondsDuration = FormattedDuration[inMilliseconds]
+	SecondsDuration      = FormattedDuration[inSeconds]
+	FloatSecondsDuration = FormattedDuration[inFloatSeconds]
+	ObjectDuration       = FormattedDuration[inObject]
+	IntervalDuration     = FormattedDuration[inInterval]
+)
+
+func (d FormattedDuration[F]) formats() DurationFormats {
+	var f F
+	return f.DurationFormats()
+}
+
+func (d FormattedDuration[F]) String() string {
+	return Duration(d).String()
+}
+
+// Value implements the driver Valuer interface, in the SQL format of F.
+func (d FormattedDuration[F]) Value() (driver.Value, error) {
+	return Duration(d).value(d.formats().SQL)
+}
+
+// Scan implements the Scanner interface, in the SQL format of F.
+func (d *FormattedDuration[F]) Scan(v interface{}) error {
+	return (*Duration)(d).scan(v, d.formats().SQL)
+}
+
+// MarshalJSON takes a duration and marshal it in the JSON format of F
+func (d FormattedDuration[F]) MarshalJSON() ([]byte, error) {
+	return Duration(d).marshalJSON(d.formats().JSON)
+}
+
+// UnmarshalJSON takes a slice of bytes and convert it to a duration in the
+// JSON format of F
+func (d *FormattedDuration[F]) UnmarshalJSON(b []byte) error {
+	return (*Duration)(d).unmarshalJSON(b, d.formats().JSON)
+}
+
+// MarshalText takes a duration and marshal it in the Text format of F
+func (d FormattedDuration[F]) MarshalText() ([]byte, error) {
+	return Duration(d).marshalText(d.formats().Text)
+}
+
+// UnmarshalText takes a string and converts it to a duration in the Text
+// format of F
+func (d *FormattedDuration[F]) UnmarshalText(b []byte) error {
+	return (*Duration)(d).unmarshalText(b, d.formats().Text)
+}
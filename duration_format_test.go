@@ -0,0 +1,268 @@
+package extratypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+type testDurationFormats struct{}
+
+func (testDurationFormats) DurationFormats() DurationFormats {
+	return DurationFormats{
+		JSON: DurationMilliseconds,
+		Text: DurationGo,
+		SQL:  DurationSeconds,
+	}
+}
+
+func TestDurationFormatMarshal(t *testing.T) {
+	d := 90*time.Second + 500*time.Millisecond
+
+	type toCheck = struct {
+		f        DurationFormat
+		json     []byte
+		text     []byte
+		sqlValue interface{}
+	}
+
+	checks := []toCheck{
+		toCheck{f: DurationGo, json: []byte(`"1m30.5s"`), text: []byte(`1m30.5s`), sqlValue: "1m30.5s"},
+		toCheck{f: DurationISO8601, json: []byte(`"PT1M30.5S"`), text: []byte(`PT1M30.5S`), sqlValue: "PT1M30.5S"},
+		toCheck{f: DurationNanoseconds, json: []byte(`90500000000`), text: []byte(`90500000000`), sqlValue: int64(90500000000)},
+		toCheck{f: DurationMilliseconds, json: []byte(`90500`), text: []byte(`90500`), sqlValue: int64(90500)},
+		toCheck{f: DurationSeconds, json: []byte(`90`), text: []byte(`90`), sqlValue: int64(90)},
+		toCheck{f: DurationFloatSeconds, json: []byte(`90.5`), text: []byte(`90.5`), sqlValue: 90.5},
+		toCheck{f: DurationObject, json: []byte(`{"seconds":90,"nanos":500000000}`), text: []byte(`{"seconds":90,"nanos":500000000}`), sqlValue: `{"seconds":90,"nanos":500000000}`},
+	}
+
+	for _, check := range checks {
+		dur := Duration{Duration: d}
+
+		b, err := dur.marshalJSON(check.f)
+		if err != nil || !bytes.Equal(b, check.json) {
+			t.Errorf("%d: json %s is not %s: %v", check.f, b, check.json, err)
+		}
+
+		b, err = dur.marshalText(check.f)
+		if err != nil || !bytes.Equal(b, check.text) {
+			t.Errorf("%d: text %s is not %s: %v", check.f, b, check.text, err)
+		}
+
+		v, err := dur.value(check.f)
+		if err != nil || v != check.sqlValue {
+			t.Errorf("%d: value (%T) %v is not (%T) %v: %v", check.f, v, v, check.sqlValue, check.sqlValue, err)
+		}
+
+		var result Duration
+		expected := d
+		if check.f == DurationMilliseconds || check.f == DurationSeconds {
+			expected = d.Truncate(check.f.unit())
+		}
+
+		err = result.unmarshalJSON(check.json, check.f)
+		if err != nil || result.Duration != expected {
+			t.Errorf("%d: json %s gave %s: %v", check.f, check.json, result, err)
+		}
+
+		err = result.unmarshalText(check.text, check.f)
+		if err != nil || result.Duration != expected {
+			t.Errorf("%d: text %s gave %s: %v", check.f, check.text, result, err)
+		}
+
+		err = result.scan(check.sqlValue, check.f)
+		if err != nil || result.Duration != expected {
+			t.Errorf("%d: sql %v gave %s: %v", check.f, check.sqlValue, result, err)
+		}
+	}
+}
+
+func TestDurationFormatUnmarshal(t *testing.T) {
+	type toCheck = struct {
+		f        DurationFormat
+		b        []byte
+		expected time.Duration
+	}
+
+	checks := []toCheck{
+		toCheck{f: DurationMilliseconds, b: []byte(`"1500"`), expected: 1500 * time.Millisecond},
+		toCheck{f: DurationMilliseconds, b: []byte(`"1m"`), expected: time.Minute},
+		toCheck{f: DurationSeconds, b: []byte(`1.5`), expected: 1500 * time.Millisecond},
+		toCheck{f: DurationFloatSeconds, b: []byte(`"0.25"`), expected: 250 * time.Millisecond},
+		toCheck{f: DurationObject, b: []byte(`{"seconds":"-1","nanos":-500000000}`), expected: -1500 * time.Millisecond},
+		toCheck{f: DurationObject, b: []byte(`{"seconds":3}`), expected: 3 * time.Second},
+		toCheck{f: DurationObject, b: []byte(`{}`), expected: 0},
+		toCheck{f: DurationObject, b: []byte(`"2s"`), expected: 2 * time.Second},
+	}
+
+	for _, check := range checks {
+		var result Duration
+		err := result.unmarshalJSON(check.b, check.f)
+		if err != nil || result.Duration != check.expected || result.Nil {
+			t.Errorf("%d: %s gave %+v: %v", check.f, check.b, result, err)
+		}
+	}
+
+	var result Duration
+	err := result.unmarshalJSON([]byte(`{"seconds":1,"minutes":1}`), DurationObject)
+	if !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+	}
+
+	err = result.unmarshalJSON([]byte(`9223372036854775807`), DurationSeconds)
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected '%s', got '%v'", ErrOverflow, err)
+	}
+
+	err = result.unmarshalJSON([]byte(`null`), DurationObject)
+	if err != nil || !result.Nil {
+		t.Errorf("result %+v expected to be nil: %v", result, err)
+	}
+}
+
+func TestDurationFormatGlobal(t *testing.T) {
+	DurationJSONFormat = DurationMilliseconds
+	DurationSQLFormat = DurationSeconds
+	defer func() {
+		DurationJSONFormat = DurationGo
+		DurationSQLFormat = DurationNanoseconds
+	}()
+
+	d := Duration{Duration: 2 * time.Second}
+	b, err := d.MarshalJSON()
+	if err != nil || !bytes.Equal(b, []byte(`2000`)) {
+		t.Errorf("b %s is not 2000: %v", b, err)
+	}
+
+	v, err := d.Value()
+	if err != nil || v != int64(2) {
+		t.Errorf("v %v is not 2: %v", v, err)
+	}
+
+	v, err = Duration{Duration: -1, Nil: true}.Value()
+	if err != nil || v != nil {
+		t.Errorf("v %v is not nil: %v", v, err)
+	}
+
+	err = d.Scan(int64(30))
+	if err != nil || d.Duration != 30*time.Second {
+		t.Errorf("d %s is not 30s: %v", d, err)
+	}
+}
+
+func TestFormattedDuration(t *testing.T) {
+	type job struct {
+		Timeout  MillisecondsDuration                   `json:"timeout"`
+		Interval FormattedDuration[testDurationFormats] `json:"interval"`
+		Backoff  ObjectDuration                         `json:"backoff"`
+	}
+
+	j := job{
+		Timeout:  MillisecondsDuration{Duration: 1500 * time.Millisecond},
+		Interval: FormattedDuration[testDurationFormats]{Duration: time.Minute},
+		Backoff:  ObjectDuration{Nil: true},
+	}
+
+	b, err := json.Marshal(j)
+	expected := []byte(`{"timeout":1500,"interval":60000,"backoff":null}`)
+	if err != nil || !bytes.Equal(b, expected) {
+		t.Errorf("b %s is not %s: %v", b, expected, err)
+	}
+
+	var result job
+	err = json.Unmarshal(b, &result)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	if result.Timeout.Duration != 1500*time.Millisecond || result.Interval.Duration != time.Minute || !result.Backoff.Nil {
+		t.Errorf("result %+v is not %+v", result, j)
+	}
+
+	text, err := j.Interval.MarshalText()
+	if err != nil || !bytes.Equal(text, []byte("1m0s")) {
+		t.Errorf("text %s is not 1m0s: %v", text, err)
+	}
+
+	if j.Interval.String() != "1m0s" || j.Interval.Minutes() != 1 {
+		t.Errorf("interval %s is not 1m0s", j.Interval)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(int64(1500), int64(60), nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (timeout, interval, backoff)", j.Timeout, j.Interval, j.Backoff)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+	rows := mock.NewRows([]string{"interval"}).AddRow(int64(90)).AddRow([]byte("90"))
+	mock.ExpectQuery("SELECT").WillReturnRows(rows)
+	rs, _ := db.Query("SELECT")
+	defer rs.Close()
+	for rs.Next() {
+		var interval FormattedDuration[testDurationFormats]
+		err := rs.Scan(&interval)
+		if err != nil || interval.Duration != 90*time.Second {
+			t.Errorf("interval %s is not 90s: %v", interval, err)
+		}
+	}
+}
+
+func TestDurationFormatZeroAndNegative(t *testing.T) {
+	formats := []DurationFormat{
+		DurationGo, DurationISO8601, DurationMilliseconds, DurationSeconds,
+		DurationFloatSeconds, DurationObject, DurationInterval,
+	}
+	values := []time.Duration{0, -1500 * time.Millisecond, -2 * time.Second}
+
+	for _, f := range formats {
+		for _, d := range values {
+			expected := d
+			if f == DurationMilliseconds || f == DurationSeconds {
+				expected = d.Truncate(f.unit())
+			}
+
+			v, err := Duration{Duration: d}.value(f)
+			if err != nil {
+				t.Errorf("%d: %s: unexpected error: %s", f, d, err)
+				continue
+			}
+
+			var result Duration
+			err = result.scan(v, f)
+			if err != nil || result.Nil || result.Duration != expected {
+				t.Errorf("%d: sql %v gave %+v, expected %s: %v", f, v, result, expected, err)
+			}
+
+			b, err := Duration{Duration: d}.marshalJSON(f)
+			if err != nil {
+				t.Errorf("%d: %s: unexpected error: %s", f, d, err)
+				continue
+			}
+
+			result = Duration{}
+			err = result.unmarshalJSON(b, f)
+			if err != nil || result.Nil || result.Duration != expected {
+				t.Errorf("%d: json %s gave %+v, expected %s: %v", f, b, result, expected, err)
+			}
+		}
+	}
+
+	var result Duration
+	err := result.scan(0.0, DurationNanoseconds)
+	if err != nil || !result.Nil {
+		t.Errorf("result %+v expected to be nil: %v", result, err)
+	}
+}
@@ -0,0 +1,216 @@
+package extratypes
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// humanUnits are the units of a human duration, by their short and long
+// names. Months and years are not here, because "m" is a minute.
+var humanUnits = map[string]time.Duration{
+	"ns":           time.Nanosecond,
+	"nsec":         time.Nanosecond,
+	"nanosecond":   time.Nanosecond,
+	"nanoseconds":  time.Nanosecond,
+	"us":           time.Microsecond,
+	"µs":           time.Microsecond,
+	"μs":           time.Microsecond,
+	"usec":         time.Microsecond,
+	"microsecond":  time.Microsecond,
+	"microseconds": time.Microsecond,
+	"ms":           time.Millisecond,
+	"msec":         time.Millisecond,
+	"millisecond":  time.Millisecond,
+	"milliseconds": time.Millisecond,
+	"s":            time.Second,
+	"sec":          time.Second,
+	"secs":         time.Second,
+	"second":       time.Second,
+	"seconds":      time.Second,
+	"m":            time.Minute,
+	"min":          time.Minute,
+	"mins":         time.Minute,
+	"minute":       time.Minute,
+	"minutes":      time.Minute,
+	"h":            time.Hour,
+	"hr":           time.Hour,
+	"hrs":          time.Hour,
+	"hour":         time.Hour,
+	"hours":        time.Hour,
+	"d":            oneDay,
+	"day":          oneDay,
+	"days":         oneDay,
+	"w":            7 * oneDay,
+	"wk":           7 * oneDay,
+	"wks":          7 * oneDay,
+	"week":         7 * oneDay,
+	"weeks":        7 * oneDay,
+}
+
+// humanNames are the units that Humanize writes, by their short, singular
+// and plural names.
+var humanNames = []struct {
+	unit                  time.Duration
+	short, single, plural string
+}{
+	{oneDay, "d", "day", "days"},
+	{time.Hour, "h", "hour", "hours"},
+	{time.Minute, "m", "minute", "minutes"},
+}
+
+// parseHumanDuration parses a duration that is written by a person, such
+// as "3d", "2w", "1d4h" or "1 day, 4 hours and 30 minutes". A day is 24
+// hours and a week is 7 days. A sign is allowed only at the start.
+func parseHumanDuration(s string) (time.Duration, error) {
+	str := strings.ToLower(strings.TrimSpace(s))
+
+	neg := false
+	if str != "" && (str[0] == '-' || str[0] == '+') {
+		neg = str[0] == '-'
+		str = strings.TrimSpace(str[1:])
+	}
+
+	if str == "" {
+		return 0, ErrSyntax
+	}
+
+	var total time.Duration
+	for str != "" {
+		i := strings.IndexFunc(str, func(r rune) bool {
+			return !(r >= '0' && r <= '9' || r == '.')
+		})
+		if i <= 0 {
+			return 0, ErrSyntax
+		}
+
+		num := str[:i]
+		str = strings.TrimLeft(str[i:], " \t")
+
+		j := strings.IndexFunc(str, func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		if j < 0 {
+			j = len(str)
+		}
+
+		unit, ok := humanUnits[str[:j]]
+		if !ok {
+			return 0, ErrSyntax
+		}
+
+		d, err := durationOf(num, unit)
+		if err != nil {
+			return 0, err
+		}
+
+		total, err = addDuration(total, d)
+		if err != nil {
+			return 0, err
+		}
+
+		str = skipHumanSeparators(str[j:])
+	}
+
+	if neg {
+		total = -total
+	}
+
+	return total, nil
+}
+
+// skipHumanSeparators removes the spaces, commas and "and" words from the
+// start of s
+func skipHumanSeparators(s string) string {
+	for {
+		trimmed := strings.TrimLeft(s, " \t,")
+		if strings.HasPrefix(trimmed, "and ") {
+			trimmed = trimmed[len("and "):]
+		}
+
+		if trimmed == s {
+			return s
+		}
+		s = trimmed
+	}
+}
+
+// Humanize returns the duration in days, hours, minutes and seconds, such
+// as "1d4h30m15.5s". A duration that is shorter than a second is written
+// as time.Duration does, such as "500ms".
+func (d Duration) Humanize() string {
+	if d.Nil {
+		return "nil"
+	}
+
+	return humanize(d.Duration, false)
+}
+
+// HumanizeLong returns the duration in days, hours, minutes and seconds,
+// with the long names of the units, such as "1 day 4 hours 30 minutes".
+func (d Duration) HumanizeLong() string {
+	if d.Nil {
+		return "nil"
+	}
+
+	return humanize(d.Duration, true)
+}
+
+func humanize(d time.Duration, long bool) string {
+	if !long && d > -time.Second && d < time.Second {
+		return d.String()
+	}
+
+	if d == 0 {
+		return "0 seconds"
+	}
+
+	var parts []string
+	u := uint64(d)
+	if d < 0 {
+		u = -u
+	}
+
+	for _, name := range humanNames {
+		n := u / uint64(name.unit)
+		u %= uint64(name.unit)
+		if n == 0 {
+			continue
+		}
+
+		parts = append(parts, humanPart(strconv.FormatUint(n, 10), n == 1,
+			name.short, name.single, name.plural, long))
+	}
+
+	if u > 0 {
+		sec := formatSeconds(u)
+		parts = append(parts, humanPart(sec, sec == "1", "s", "second", "seconds", long))
+	}
+
+	sep := ""
+	if long {
+		sep = " "
+	}
+
+	s := strings.Join(parts, sep)
+	if d < 0 {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// humanPart writes num with the short name of its unit, or with the long
+// one after a space.
+func humanPart(num string, single bool, short, singular, plural string, long bool) string {
+	if !long {
+		return num + short
+	}
+
+	if single {
+		return num + " " + singular
+	}
+
+	return num + " " + plural
+}
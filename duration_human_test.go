@@ -0,0 +1,128 @@
+package extratypes
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseHumanDuration(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		expected time.Duration
+	}
+
+	checks := []toCheck{
+		toCheck{s: "3d", expected: 72 * time.Hour},
+		toCheck{s: "2w", expected: 14 * 24 * time.Hour},
+		toCheck{s: "1d4h", expected: 28 * time.Hour},
+		toCheck{s: "1.5d", expected: 36 * time.Hour},
+		toCheck{s: "1 day 4 hours", expected: 28 * time.Hour},
+		toCheck{s: "1 day, 4 hours and 30 minutes", expected: 28*time.Hour + 30*time.Minute},
+		toCheck{s: "2 Weeks 1 Day", expected: 15 * 24 * time.Hour},
+		toCheck{s: "90 secs", expected: 90 * time.Second},
+		toCheck{s: "1 hr 15 min", expected: 75 * time.Minute},
+		toCheck{s: "250 msec", expected: 250 * time.Millisecond},
+		toCheck{s: "3 µs", expected: 3 * time.Microsecond},
+		toCheck{s: "-1d 12h", expected: -36 * time.Hour},
+	}
+
+	for _, check := range checks {
+		d, err := parseHumanDuration(check.s)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.s, err)
+			continue
+		}
+
+		if d != check.expected {
+			t.Errorf("%s: %s is not %s", check.s, d, check.expected)
+		}
+	}
+}
+
+func TestParseHumanDurationError(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		expected error
+	}
+
+	checks := []toCheck{
+		toCheck{s: "", expected: ErrSyntax},
+		toCheck{s: "3", expected: ErrSyntax},
+		toCheck{s: "day", expected: ErrSyntax},
+		toCheck{s: "3 fortnights", expected: ErrSyntax},
+		toCheck{s: "1d -4h", expected: ErrSyntax},
+		toCheck{s: "1d and", expected: ErrSyntax},
+		toCheck{s: "200000w", expected: ErrOverflow},
+	}
+
+	for _, check := range checks {
+		_, err := parseHumanDuration(check.s)
+		if !errors.Is(err, check.expected) {
+			t.Errorf("%q: expected '%s', got '%v'", check.s, check.expected, err)
+		}
+	}
+}
+
+func TestDurationHumanUnits(t *testing.T) {
+	var d Duration
+	err := d.UnmarshalText([]byte("1 day 4 hours"))
+	if err != nil || d.Duration != 28*time.Hour {
+		t.Errorf("d %s is not 28h: %v", d, err)
+	}
+
+	err = d.UnmarshalJSON([]byte(`"3d"`))
+	if err != nil || d.Duration != 72*time.Hour {
+		t.Errorf("d %s is not 72h: %v", d, err)
+	}
+
+	err = d.Scan("2w")
+	if err != nil || d.Duration != 14*24*time.Hour {
+		t.Errorf("d %s is not 336h: %v", d, err)
+	}
+
+	err = d.UnmarshalText([]byte("1x"))
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Err.Error() != `time: unknown unit "x" in duration "1x"` {
+		t.Errorf("Expected the error of time.ParseDuration, got '%v'", err)
+	}
+}
+
+func TestDurationHumanize(t *testing.T) {
+	type toCheck = struct {
+		d     Duration
+		short string
+		long  string
+	}
+
+	checks := []toCheck{
+		toCheck{d: Duration{Duration: 28 * time.Hour}, short: "1d4h", long: "1 day 4 hours"},
+		toCheck{d: Duration{Duration: 49*time.Hour + time.Minute + 1500*time.Millisecond}, short: "2d1h1m1.5s", long: "2 days 1 hour 1 minute 1.5 seconds"},
+		toCheck{d: Duration{Duration: time.Second}, short: "1s", long: "1 second"},
+		toCheck{d: Duration{Duration: -90 * time.Minute}, short: "-1h30m", long: "-1 hour 30 minutes"},
+		toCheck{d: Duration{Duration: 500 * time.Millisecond}, short: "500ms", long: "0.5 seconds"},
+		toCheck{d: Duration{}, short: "0s", long: "0 seconds"},
+		toCheck{d: Duration{Duration: -1, Nil: true}, short: "nil", long: "nil"},
+	}
+
+	for _, check := range checks {
+		if s := check.d.Humanize(); s != check.short {
+			t.Errorf("%d: %s is not %s", check.d.Duration, s, check.short)
+		}
+
+		if s := check.d.HumanizeLong(); s != check.long {
+			t.Errorf("%d: %s is not %s", check.d.Duration, s, check.long)
+		}
+
+		if check.d.Nil {
+			continue
+		}
+
+		for _, s := range []string{check.short, check.long} {
+			d, err := parseDuration(s)
+			if err != nil || d != check.d.Duration {
+				t.Errorf("%s: round trip gave %s: %v", s, d, err)
+			}
+		}
+	}
+}
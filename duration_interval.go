@@ -0,0 +1,197 @@
+package extratypes
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseInterval parses the text of a PostgreSQL INTERVAL or a MySQL TIME,
+// such as "1 day 02:03:04.5", "-1 days +02:03:04", "1 year 2 mons",
+// "@ 1 day 2 hours ago", "1 2:03:04" (days and a time) or "838:59:59".
+func parseInterval(s string) (time.Duration, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) > 0 && fields[0] == "@" {
+		fields = fields[1:]
+	}
+
+	ago := false
+	if len(fields) > 0 && fields[len(fields)-1] == "ago" {
+		ago = true
+		fields = fields[:len(fields)-1]
+	}
+
+	if len(fields) == 0 {
+		return 0, ErrSyntax
+	}
+
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+
+		var d time.Duration
+		var err error
+		switch {
+		case strings.Contains(field, ":"):
+			d, err = parseClock(field)
+		case isYearMonth(field):
+			d, err = parseYearMonth(field)
+		case i+1 < len(fields) && intervalUnit(fields[i+1]) != 0:
+			d, err = durationOf(field, intervalUnit(fields[i+1]))
+			i++
+		case i+1 < len(fields) && strings.Contains(fields[i+1], ":"):
+			// The days of the SQL standard format, such as "1 2:03:04"
+			d, err = durationOf(field, oneDay)
+		default:
+			return 0, ErrSyntax
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		total, err = addDuration(total, d)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if ago {
+		total = -total
+	}
+
+	return total, nil
+}
+
+// intervalUnit returns the length of the unit name, or 0 if it is not a
+// unit. The units are the ones of humanUnits, and years and months as
+// PostgreSQL writes them, that are resolved by the policy of ISO8601Year
+// and ISO8601Month.
+func intervalUnit(name string) time.Duration {
+	switch name {
+	case "y", "yr", "yrs", "year", "years":
+		return ISO8601Year
+	case "mon", "mons", "month", "months":
+		return ISO8601Month
+	}
+
+	return humanUnits[name]
+}
+
+// parseClock parses [-]H:MM[:SS[.frac]], the hours are not limited to a
+// single day.
+func parseClock(s string) (time.Duration, error) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, ErrSyntax
+	}
+
+	var total time.Duration
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	for i, part := range parts {
+		if part == "" || strings.ContainsAny(part, "+-") {
+			return 0, ErrSyntax
+		}
+
+		if i < len(parts)-1 && strings.ContainsAny(part, ".,") {
+			return 0, ErrSyntax
+		}
+
+		if i > 0 {
+			if v, err := strconv.ParseFloat(part, 64); err != nil || v >= 60 {
+				return 0, ErrSyntax
+			}
+		}
+
+		d, err := durationOf(part, units[i])
+		if err != nil {
+			return 0, err
+		}
+
+		total, err = addDuration(total, d)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if neg {
+		total = -total
+	}
+
+	return total, nil
+}
+
+// isYearMonth reports whether s is the years and months of the SQL
+// standard format, such as "1-2"
+func isYearMonth(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	i := strings.IndexByte(s, '-')
+	if i <= 0 || i == len(s)-1 {
+		return false
+	}
+
+	return strings.Trim(s[:i]+s[i+1:], "0123456789") == ""
+}
+
+// parseYearMonth parses the years and months of the SQL standard format
+func parseYearMonth(s string) (time.Duration, error) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+	i := strings.IndexByte(s, '-')
+
+	years, err := durationOf(s[:i], ISO8601Year)
+	if err != nil {
+		return 0, err
+	}
+
+	months, err := durationOf(s[i+1:], ISO8601Month)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := addDuration(years, months)
+	if err != nil {
+		return 0, err
+	}
+
+	if neg {
+		total = -total
+	}
+
+	return total, nil
+}
+
+// formatInterval formats d as [-]HH:MM:SS[.frac], that PostgreSQL reads as
+// an INTERVAL and MySQL as a TIME.
+func formatInterval(d time.Duration) string {
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+
+	h := u / uint64(time.Hour)
+	m := u % uint64(time.Hour) / uint64(time.Minute)
+	s := u % uint64(time.Minute)
+
+	if h < 10 {
+		b.WriteByte('0')
+	}
+	b.WriteString(strconv.FormatUint(h, 10))
+	b.WriteByte(':')
+	if m < 10 {
+		b.WriteByte('0')
+	}
+	b.WriteString(strconv.FormatUint(m, 10))
+	b.WriteByte(':')
+	if s < uint64(10*time.Second) {
+		b.WriteByte('0')
+	}
+	b.WriteString(formatSeconds(s))
+
+	return b.String()
+}
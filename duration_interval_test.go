@@ -0,0 +1,146 @@
+package extratypes
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestParseInterval(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		expected time.Duration
+	}
+
+	checks := []toCheck{
+		toCheck{s: "1 day 02:03:04.5", expected: 26*time.Hour + 3*time.Minute + 4500*time.Millisecond},
+		toCheck{s: "-00:00:01", expected: -time.Second},
+		toCheck{s: "-1 days +02:03:04", expected: -22*time.Hour + 3*time.Minute + 4*time.Second},
+		toCheck{s: "3 days", expected: 72 * time.Hour},
+		toCheck{s: "1 year 2 mons 3 days 04:05:06", expected: (365+60+3)*24*time.Hour + 4*time.Hour + 5*time.Minute + 6*time.Second},
+		toCheck{s: "@ 1 day 2 hours 3 mins 4.5 secs ago", expected: -(26*time.Hour + 3*time.Minute + 4500*time.Millisecond)},
+		toCheck{s: "1 2:03:04.5", expected: 26*time.Hour + 3*time.Minute + 4500*time.Millisecond},
+		toCheck{s: "+1-2 +3 +4:05:06", expected: (365+60+3)*24*time.Hour + 4*time.Hour + 5*time.Minute + 6*time.Second},
+		toCheck{s: "838:59:59", expected: 838*time.Hour + 59*time.Minute + 59*time.Second},
+		toCheck{s: "-12:30:00.000001", expected: -(12*time.Hour + 30*time.Minute + time.Microsecond)},
+		toCheck{s: "12:30", expected: 12*time.Hour + 30*time.Minute},
+	}
+
+	for _, check := range checks {
+		d, err := parseInterval(check.s)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.s, err)
+			continue
+		}
+
+		if d != check.expected {
+			t.Errorf("%s: %s is not %s", check.s, d, check.expected)
+		}
+	}
+}
+
+func TestParseIntervalError(t *testing.T) {
+	checks := []string{
+		"",
+		"@",
+		"days",
+		"1 fortnight",
+		"1:2:3:4",
+		"1:60:00",
+		"1:00:60",
+		"1.5:00:00",
+		"1:-1:00",
+		"1 2",
+	}
+
+	for _, check := range checks {
+		_, err := parseInterval(check)
+		if !errors.Is(err, ErrSyntax) {
+			t.Errorf("%q: expected '%s', got '%v'", check, ErrSyntax, err)
+		}
+	}
+}
+
+func TestFormatInterval(t *testing.T) {
+	type toCheck = struct {
+		d        time.Duration
+		expected string
+	}
+
+	checks := []toCheck{
+		toCheck{d: 0, expected: "00:00:00"},
+		toCheck{d: 36 * time.Hour, expected: "36:00:00"},
+		toCheck{d: 26*time.Hour + 3*time.Minute + 4500*time.Millisecond, expected: "26:03:04.5"},
+		toCheck{d: -time.Second, expected: "-00:00:01"},
+		toCheck{d: 838*time.Hour + 59*time.Minute + 59*time.Second, expected: "838:59:59"},
+	}
+
+	for _, check := range checks {
+		s := formatInterval(check.d)
+		if s != check.expected {
+			t.Errorf("%d: %s is not %s", check.d, s, check.expected)
+		}
+
+		d, err := parseInterval(s)
+		if err != nil || d != check.d {
+			t.Errorf("%s: round trip gave %s: %v", s, d, err)
+		}
+	}
+}
+
+func TestDurationScanInterval(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("expected no error, but got: %s", err)
+		return
+	}
+	defer db.Close()
+
+	expected := 26*time.Hour + 3*time.Minute + 4*time.Second
+	rows := mock.NewRows([]string{"duration"}).
+		AddRow("1 day 02:03:04").
+		AddRow([]byte("1 day 02:03:04")).
+		AddRow("P1DT2H3M4S").
+		AddRow("26:03:04").
+		AddRow([]byte("26:03:04"))
+
+	mock.ExpectQuery("SELECT").WillReturnRows(rows)
+	rs, _ := db.Query("SELECT")
+	defer rs.Close()
+	for rs.Next() {
+		var d Duration
+		err := rs.Scan(&d)
+		if err != nil || d.Duration != expected || d.Nil {
+			t.Errorf("d %s is not %s: %v", d, expected, err)
+		}
+	}
+
+	if rs.Err() != nil {
+		t.Errorf("got rows error: %s", rs.Err())
+	}
+}
+
+func TestDurationValueInterval(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	DurationSQLFormat = DurationInterval
+	defer func() { DurationSQLFormat = DurationNanoseconds }()
+
+	d := Duration{Duration: 26*time.Hour + 3*time.Minute + 4*time.Second}
+	i := IntervalDuration{Duration: -time.Second}
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs("26:03:04", "-00:00:01", nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (d)", d, i, Duration{Duration: -1, Nil: true})
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+}
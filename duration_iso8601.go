@@ -0,0 +1,223 @@
+package extratypes
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The length of the calendar units of an ISO 8601 duration. A year and a
+// month do not have a fixed length, so a Duration resolves them by the
+// following policy: a day is 24 hours, a week is 7 days, a month is 30
+// days and a year is 365 days. Changing them affects both parsing and the
+// result of existing values.
+var (
+	ISO8601Day   = 24 * time.Hour
+	ISO8601Week  = 7 * 24 * time.Hour
+	ISO8601Month = 30 * 24 * time.Hour
+	ISO8601Year  = 365 * 24 * time.Hour
+)
+
+// isISO8601Duration reports whether s looks like an ISO 8601 duration
+func isISO8601Duration(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	return len(s) > 0 && (s[0] == 'P' || s[0] == 'p')
+}
+
+// parseISO8601Duration parses an ISO 8601 duration such as "PT1H30M",
+// "P2DT3H" or "-P1W". A fraction is allowed on every component, and each
+// component may have its own sign, as PostgreSQL writes "P-1DT2H".
+func parseISO8601Duration(s string) (time.Duration, error) {
+	str := strings.ToUpper(strings.TrimSpace(s))
+
+	neg := false
+	if str != "" && (str[0] == '-' || str[0] == '+') {
+		neg = str[0] == '-'
+		str = str[1:]
+	}
+
+	if len(str) < 2 || str[0] != 'P' {
+		return 0, ErrSyntax
+	}
+	str = str[1:]
+
+	var total time.Duration
+	inTime := false
+	for str != "" {
+		if str[0] == 'T' {
+			if inTime || len(str) == 1 {
+				return 0, ErrSyntax
+			}
+			inTime = true
+			str = str[1:]
+			continue
+		}
+
+		i := 0
+		if str[0] == '-' || str[0] == '+' {
+			i++
+		}
+		for i < len(str) && (str[i] >= '0' && str[i] <= '9' || str[i] == '.' || str[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(str) {
+			return 0, ErrSyntax
+		}
+
+		unit, ok := iso8601Unit(str[i], inTime)
+		if !ok {
+			return 0, ErrSyntax
+		}
+
+		d, err := durationOf(str[:i], unit)
+		if err != nil {
+			return 0, err
+		}
+
+		total, err = addDuration(total, d)
+		if err != nil {
+			return 0, err
+		}
+		str = str[i+1:]
+	}
+
+	if neg {
+		total = -total
+	}
+
+	return total, nil
+}
+
+// iso8601Unit returns the length of the designator c, that is a date or a
+// time designator by inTime.
+func iso8601Unit(c byte, inTime bool) (time.Duration, bool) {
+	if inTime {
+		switch c {
+		case 'H':
+			return time.Hour, true
+		case 'M':
+			return time.Minute, true
+		case 'S':
+			return time.Second, true
+		}
+		return 0, false
+	}
+
+	switch c {
+	case 'Y':
+		return ISO8601Year, true
+	case 'M':
+		return ISO8601Month, true
+	case 'W':
+		return ISO8601Week, true
+	case 'D':
+		return ISO8601Day, true
+	}
+	return 0, false
+}
+
+// durationOf returns num units as a Duration. num is a decimal number with
+// an optional sign and fraction, that is separated by a dot or a comma.
+func durationOf(num string, unit time.Duration) (time.Duration, error) {
+	num = strings.Replace(num, ",", ".", 1)
+
+	whole, frac := num, ""
+	if i := strings.IndexByte(num, '.'); i >= 0 {
+		whole, frac = num[:i], num[i+1:]
+	}
+
+	neg := strings.HasPrefix(whole, "-")
+	whole = strings.TrimLeft(whole, "+-")
+	if whole == "" && frac == "" {
+		return 0, ErrSyntax
+	}
+
+	var w int64
+	if whole != "" {
+		var err error
+		w, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return 0, strconvErr(err)
+		}
+	}
+
+	if w > math.MaxInt64/int64(unit) {
+		return 0, ErrOverflow
+	}
+	d := time.Duration(w) * unit
+
+	if frac != "" {
+		f, err := strconv.ParseFloat("0."+frac, 64)
+		if err != nil {
+			return 0, ErrSyntax
+		}
+
+		var ferr error
+		d, ferr = addDuration(d, time.Duration(math.Round(f*float64(unit))))
+		if ferr != nil {
+			return 0, ferr
+		}
+	}
+
+	if neg {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// addDuration returns a+b, or ErrOverflow when it does not fit a Duration
+func addDuration(a, b time.Duration) (time.Duration, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, ErrOverflow
+	}
+
+	return c, nil
+}
+
+// formatISO8601Duration formats d as an ISO 8601 duration. Only the
+// hours, minutes and seconds are used, because they have a fixed length,
+// so 36 hours are "PT36H" and not "P1DT12H".
+func formatISO8601Duration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+
+	if h := u / uint64(time.Hour); h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10))
+		b.WriteByte('H')
+	}
+	if m := u % uint64(time.Hour) / uint64(time.Minute); m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10))
+		b.WriteByte('M')
+	}
+
+	if s := u % uint64(time.Minute); s > 0 {
+		b.WriteString(formatSeconds(s))
+		b.WriteByte('S')
+	}
+
+	return b.String()
+}
+
+// formatSeconds writes ns nanoseconds as seconds, with a fraction only
+// when there is one, such as "15" or "15.5"
+func formatSeconds(ns uint64) string {
+	s := strconv.FormatUint(ns/uint64(time.Second), 10)
+	if frac := ns % uint64(time.Second); frac > 0 {
+		digits := strconv.FormatUint(frac+uint64(time.Second), 10)[1:]
+		s += "." + strings.TrimRight(digits, "0")
+	}
+
+	return s
+}
@@ -0,0 +1,154 @@
+package extratypes
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseISO8601Duration(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		expected time.Duration
+	}
+
+	checks := []toCheck{
+		toCheck{s: "PT1H30M", expected: 90 * time.Minute},
+		toCheck{s: "P2DT3H", expected: 51 * time.Hour},
+		toCheck{s: "PT0S", expected: 0},
+		toCheck{s: "P0D", expected: 0},
+		toCheck{s: "PT1.5S", expected: 1500 * time.Millisecond},
+		toCheck{s: "PT0,25S", expected: 250 * time.Millisecond},
+		toCheck{s: "PT1.123456789S", expected: 1123456789},
+		toCheck{s: "PT.5H", expected: 30 * time.Minute},
+		toCheck{s: "P1W", expected: 7 * 24 * time.Hour},
+		toCheck{s: "P1M", expected: 30 * 24 * time.Hour},
+		toCheck{s: "P1Y", expected: 365 * 24 * time.Hour},
+		toCheck{s: "P1Y2M3DT4H5M6S", expected: (365+60+3)*24*time.Hour + 4*time.Hour + 5*time.Minute + 6*time.Second},
+		toCheck{s: "-PT1M", expected: -time.Minute},
+		toCheck{s: "+PT1M", expected: time.Minute},
+		toCheck{s: "P-1DT2H", expected: -22 * time.Hour},
+		toCheck{s: "pt10m", expected: 10 * time.Minute},
+	}
+
+	for _, check := range checks {
+		d, err := parseISO8601Duration(check.s)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.s, err)
+			continue
+		}
+
+		if d != check.expected {
+			t.Errorf("%s: %s is not %s", check.s, d, check.expected)
+		}
+	}
+}
+
+func TestParseISO8601DurationError(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		expected error
+	}
+
+	checks := []toCheck{
+		toCheck{s: "P", expected: ErrSyntax},
+		toCheck{s: "PT", expected: ErrSyntax},
+		toCheck{s: "P1H", expected: ErrSyntax},
+		toCheck{s: "PT1D", expected: ErrSyntax},
+		toCheck{s: "P1DT", expected: ErrSyntax},
+		toCheck{s: "PT1H1", expected: ErrSyntax},
+		toCheck{s: "PTT1H", expected: ErrSyntax},
+		toCheck{s: "1H", expected: ErrSyntax},
+		toCheck{s: "P.D", expected: ErrSyntax},
+		toCheck{s: "P1000Y", expected: ErrOverflow},
+		toCheck{s: "PT9223372036854775807H", expected: ErrOverflow},
+		toCheck{s: "PT2562047H48M", expected: ErrOverflow},
+	}
+
+	for _, check := range checks {
+		_, err := parseISO8601Duration(check.s)
+		if !errors.Is(err, check.expected) {
+			t.Errorf("%s: expected '%s', got '%v'", check.s, check.expected, err)
+		}
+	}
+}
+
+func TestFormatISO8601Duration(t *testing.T) {
+	type toCheck = struct {
+		d        time.Duration
+		expected string
+	}
+
+	checks := []toCheck{
+		toCheck{d: 0, expected: "PT0S"},
+		toCheck{d: 90 * time.Minute, expected: "PT1H30M"},
+		toCheck{d: 36 * time.Hour, expected: "PT36H"},
+		toCheck{d: 1500 * time.Millisecond, expected: "PT1.5S"},
+		toCheck{d: time.Nanosecond, expected: "PT0.000000001S"},
+		toCheck{d: -time.Minute - time.Second, expected: "-PT1M1S"},
+		toCheck{d: time.Duration(-1 << 63), expected: "-PT2562047H47M16.854775808S"},
+	}
+
+	for _, check := range checks {
+		s := formatISO8601Duration(check.d)
+		if s != check.expected {
+			t.Errorf("%d: %s is not %s", check.d, s, check.expected)
+		}
+
+		if check.d == time.Duration(-1<<63) {
+			continue
+		}
+
+		d, err := parseISO8601Duration(s)
+		if err != nil || d != check.d {
+			t.Errorf("%s: round trip gave %s: %v", s, d, err)
+		}
+	}
+}
+
+func TestDurationISO8601(t *testing.T) {
+	var d Duration
+	err := d.UnmarshalJSON([]byte(`"PT1H30M"`))
+	if err != nil || d.Duration != 90*time.Minute {
+		t.Errorf("d %s is not 1h30m: %v", d, err)
+	}
+
+	err = d.UnmarshalJSON([]byte(`{"d": "P2DT3H"}`))
+	if err != nil || d.Duration != 51*time.Hour {
+		t.Errorf("d %s is not 51h: %v", d, err)
+	}
+
+	err = d.UnmarshalText([]byte("P1W"))
+	if err != nil || d.Duration != 7*24*time.Hour {
+		t.Errorf("d %s is not 168h: %v", d, err)
+	}
+
+	err = d.Scan("PT1S")
+	if err != nil || d.Duration != time.Second {
+		t.Errorf("d %s is not 1s: %v", d, err)
+	}
+
+	err = d.UnmarshalJSON([]byte(`"P1000Y"`))
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected '%s', got '%v'", ErrOverflow, err)
+	}
+
+	DurationJSONFormat = DurationISO8601
+	DurationTextFormat = DurationISO8601
+	defer func() {
+		DurationJSONFormat = DurationGo
+		DurationTextFormat = DurationGo
+	}()
+
+	d = Duration{Duration: 90 * time.Minute}
+	b, err := d.MarshalJSON()
+	if err != nil || !bytes.Equal(b, []byte(`"PT1H30M"`)) {
+		t.Errorf("b %s is not \"PT1H30M\": %v", b, err)
+	}
+
+	b, err = d.MarshalText()
+	if err != nil || !bytes.Equal(b, []byte(`PT1H30M`)) {
+		t.Errorf("b %s is not PT1H30M: %v", b, err)
+	}
+}
@@ -3,6 +3,7 @@ package extratypes
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -484,7 +485,13 @@ func TestUnmarshalTextError(t *testing.T) {
 
 	expectedErr := `time: invalid duration "` + string(testDurationTextErr) + `"`
 
-	if err.Error() != expectedErr {
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Reason != ReasonSyntax {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	if convErr.Err.Error() != expectedErr {
 		t.Errorf("Unexpected error: %s", err)
 		return
 	}
@@ -0,0 +1,167 @@
+package extratypes
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	ErrDestUnsupported = errors.New("Unsupported dest type")
+
+	// ErrUnsupported is the reason of an error when the source type
+	// cannot be converted into the target type
+	ErrUnsupported = errors.New("unsupported type")
+	// ErrOverflow is the reason of an error when the value does not fit
+	// into the range of the target type
+	ErrOverflow = errors.New("value out of range")
+	// ErrTruncated is the reason of an error when a fraction would be
+	// dropped from a floating point number
+	ErrTruncated = errors.New("value truncated")
+	// ErrNegative is the reason of an error when a negative value is
+	// converted into an unsigned type
+	ErrNegative = errors.New("negative value for unsigned type")
+	// ErrSyntax is the reason of an error when the value cannot be parsed
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrNilNotAllowed is the reason of an error when the value is nil,
+	// but the target type cannot hold nil
+	ErrNilNotAllowed = errors.New("nil is not allowed")
+
+	// ErrDivisionByZero is returned when an Integer is divided by zero
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// Reason is the cause of a ConversionError
+type Reason int
+
+// The available reasons of a ConversionError
+const (
+	ReasonUnsupported Reason = iota
+	ReasonOverflow
+	ReasonTruncated
+	ReasonNegative
+	ReasonSyntax
+	ReasonNilNotAllowed
+)
+
+var reasonErrors = map[Reason]error{
+	ReasonUnsupported:   ErrUnsupported,
+	ReasonOverflow:      ErrOverflow,
+	ReasonTruncated:     ErrTruncated,
+	ReasonNegative:      ErrNegative,
+	ReasonSyntax:        ErrSyntax,
+	ReasonNilNotAllowed: ErrNilNotAllowed,
+}
+
+func (r Reason) String() string {
+	err, ok := reasonErrors[r]
+	if !ok {
+		return fmt.Sprintf("Reason(%d)", int(r))
+	}
+
+	return err.Error()
+}
+
+// ConversionError is returned when a value cannot be converted into the
+// target type.
+//
+// errors.Is reports true between a ConversionError and the Err variable of
+// its reason (for example ErrOverflow for ReasonOverflow), and with the
+// underlying Err if there is one.
+type ConversionError struct {
+	// Value is the value that failed to convert
+	Value interface{}
+	// Kind is the Go kind of Value
+	Kind reflect.Kind
+	// Target is the type that Value was converted into
+	Target reflect.Type
+	// Reason is the cause of the error
+	Reason Reason
+	// Err is the underlying error if there is one, such as the one that
+	// was returned by the strconv or time packages
+	Err error
+}
+
+// newConversionError creates a ConversionError for src that failed to
+// convert into target
+func newConversionError(src interface{}, target reflect.Type, reason Reason, err error) *ConversionError {
+	return &ConversionError{
+		Value:  src,
+		Kind:   reflect.ValueOf(src).Kind(),
+		Target: target,
+		Reason: reason,
+		Err:    err,
+	}
+}
+
+// asConversionError turns err that was returned while converting src into
+// target, into a ConversionError. If err is one of the reason errors, it
+// is used as the Reason, otherwise it is kept as Err with the given reason.
+func asConversionError(src interface{}, target reflect.Type, reason Reason, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var convErr *ConversionError
+	if errors.As(err, &convErr) {
+		return err
+	}
+
+	for r, rErr := range reasonErrors {
+		if err == rErr {
+			return newConversionError(src, target, r, nil)
+		}
+	}
+
+	return newConversionError(src, target, reason, err)
+}
+
+func (e *ConversionError) Error() string {
+	var value string
+	switch v := e.Value.(type) {
+	case string, []byte:
+		value = fmt.Sprintf("%q", v)
+	default:
+		value = fmt.Sprintf("%v", v)
+	}
+
+	target := "<nil>"
+	if e.Target != nil {
+		target = e.Target.String()
+	}
+
+	msg := fmt.Sprintf("cannot convert %s %s into %s: %s", e.Kind, value, target, e.Reason)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+// Unwrap returns the underlying error
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the error of the reason of e
+func (e *ConversionError) Is(target error) bool {
+	return reasonErrors[e.Reason] == target
+}
+
+// ElementError is returned when an element of an array cannot be
+// converted, such as by a Sliced. Err is usually a *ConversionError.
+type ElementError struct {
+	// Index is the position of the element in the array that was read
+	Index int
+	// Err is the error of the element
+	Err error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the error of the element
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
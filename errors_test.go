@@ -0,0 +1,167 @@
+package extratypes
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestConversionErrorMessage(t *testing.T) {
+	type toCheck = struct {
+		err      *ConversionError
+		expected string
+	}
+
+	checks := []toCheck{
+		toCheck{
+			err:      newConversionError("abc", reflect.TypeOf(int32(0)), ReasonSyntax, nil),
+			expected: `cannot convert string "abc" into int32: invalid syntax`,
+		},
+		toCheck{
+			err:      newConversionError(1.5, reflect.TypeOf(uint(0)), ReasonTruncated, nil),
+			expected: `cannot convert float64 1.5 into uint: value truncated`,
+		},
+		toCheck{
+			err:      newConversionError(nil, durationType, ReasonNilNotAllowed, nil),
+			expected: `cannot convert invalid <nil> into extratypes.Duration: nil is not allowed`,
+		},
+		toCheck{
+			err: newConversionError("1x", durationType, ReasonSyntax,
+				errors.New(`time: unknown unit "x" in duration "1x"`)),
+			expected: `cannot convert string "1x" into extratypes.Duration: invalid syntax: time: unknown unit "x" in duration "1x"`,
+		},
+	}
+
+	for _, check := range checks {
+		if check.err.Error() != check.expected {
+			t.Errorf("Expected '%s', got '%s'", check.expected, check.err)
+		}
+	}
+}
+
+func TestConversionErrorIs(t *testing.T) {
+	for reason, reasonErr := range reasonErrors {
+		err := newConversionError(1, stringType, reason, strconv.ErrRange)
+		if !errors.Is(err, reasonErr) {
+			t.Errorf("%s is not %s", err, reasonErr)
+		}
+
+		if !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("%s does not wrap %s", err, strconv.ErrRange)
+		}
+
+		if reason.String() != reasonErr.Error() {
+			t.Errorf("reason '%s' is not '%s'", reason, reasonErr)
+		}
+	}
+
+	if Reason(-1).String() != "Reason(-1)" {
+		t.Errorf("Unexpected reason string: %s", Reason(-1))
+	}
+}
+
+func TestConversionErrorFromEntryPoints(t *testing.T) {
+	type toCheck = struct {
+		name   string
+		fn     func() error
+		reason Reason
+		kind   reflect.Kind
+		target reflect.Type
+	}
+
+	checks := []toCheck{
+		toCheck{
+			name: "Int strict scan",
+			fn: func() error {
+				var i Int16
+				return i.ScanWith("99999", Options{Strict: true})
+			},
+			reason: ReasonOverflow,
+			kind:   reflect.String,
+			target: reflect.TypeOf(int16(0)),
+		},
+		toCheck{
+			name: "Int JSON syntax",
+			fn: func() error {
+				var i Int
+				return i.UnmarshalJSON([]byte("a"))
+			},
+			reason: ReasonSyntax,
+			kind:   reflect.Slice,
+			target: reflect.TypeOf(0),
+		},
+		toCheck{
+			name: "Bool JSON syntax",
+			fn: func() error {
+				var b Bool
+				return b.UnmarshalJSON(nil)
+			},
+			reason: ReasonSyntax,
+			kind:   reflect.Slice,
+			target: reflect.TypeOf(true),
+		},
+		toCheck{
+			name: "unsupported dest",
+			fn: func() error {
+				var dest struct{}
+				_, err := toType(1, &dest)
+				return err
+			},
+			reason: ReasonUnsupported,
+			kind:   reflect.Int,
+			target: reflect.TypeOf(struct{}{}),
+		},
+		toCheck{
+			name: "Duration scan",
+			fn: func() error {
+				var d Duration
+				return d.Scan(true)
+			},
+			reason: ReasonUnsupported,
+			kind:   reflect.Bool,
+			target: durationType,
+		},
+		toCheck{
+			name: "Duration JSON",
+			fn: func() error {
+				var d Duration
+				return d.UnmarshalJSON([]byte(`"1x"`))
+			},
+			reason: ReasonSyntax,
+			kind:   reflect.String,
+			target: durationType,
+		},
+		toCheck{
+			name: "SlicedString scan",
+			fn: func() error {
+				var s SlicedString
+				return s.Scan(10)
+			},
+			reason: ReasonUnsupported,
+			kind:   reflect.Int,
+			target: slicedStringType,
+		},
+	}
+
+	for _, check := range checks {
+		err := check.fn()
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("%s: expected ConversionError, got (%T) %v", check.name, err, err)
+			continue
+		}
+
+		if convErr.Reason != check.reason {
+			t.Errorf("%s: reason '%s' is not '%s'", check.name, convErr.Reason, check.reason)
+		}
+
+		if convErr.Kind != check.kind {
+			t.Errorf("%s: kind '%s' is not '%s'", check.name, convErr.Kind, check.kind)
+		}
+
+		if convErr.Target != check.target {
+			t.Errorf("%s: target '%s' is not '%s'", check.name, convErr.Target, check.target)
+		}
+	}
+}
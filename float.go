@@ -0,0 +1,12 @@
+package extratypes
+
+// Float32 contains float32 data type that can be null, and also string
+// on JSON and SQL, but value will be converted to float32 type.
+// A value outside of the range of float32 is clamped into it
+type Float32 = Null[float32]
+
+// Float64 contains float64 data type that can be null, and also string
+// on JSON and SQL, but value will be converted to float64 type.
+//
+// NaN and infinity are accepted only when Options.AllowNonFinite is set
+type Float64 = Null[float64]
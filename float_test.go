@@ -0,0 +1,308 @@
+package extratypes
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validFloat64 = Float64{
+		Val: 1.5,
+		Nil: false,
+	}
+
+	validMinusFloat64 = Float64{
+		Val: -1.5,
+		Nil: false,
+	}
+
+	nilFloat64 = Float64{
+		Val: 0,
+		Nil: true,
+	}
+
+	validFloat32 = Float32{
+		Val: 1.5,
+		Nil: false,
+	}
+)
+
+var (
+	testFloat64JSON      = []byte("1.5")
+	testFloat64MinusJSON = []byte("-1.5")
+	testFloat64StrJSON   = []byte(`"1.5"`)
+	testFloat64NilJSON   = []byte("null")
+	testFloat64ErrJSON   = []byte("a")
+	testFloat64NaNJSON   = []byte(`"NaN"`)
+	testFloat64ValidText = []byte("1.5")
+	testFloat64NilText   = []byte("")
+)
+
+func TestFloatString(t *testing.T) {
+	if validFloat64.String() != "1.5" {
+		t.Errorf("f [%s] is not 1.5", validFloat64)
+	}
+
+	f32 := Float32{Val: 1.1}
+	if f32.String() != "1.1" {
+		t.Errorf("f32 [%s] is not 1.1", f32)
+	}
+
+	if nilFloat64.String() != "nil" {
+		t.Errorf("f [%s] is not nil", nilFloat64)
+	}
+}
+
+func TestFloatScan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"f"}).
+			AddRow(1.5).
+			AddRow("1.5").
+			AddRow([]byte("1.5"))
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var f Float64
+			err := rs.Scan(&f)
+			if err != nil {
+				te.Errorf("Unable to scan Float64: %s", err)
+			}
+			if !reflect.DeepEqual(f, validFloat64) {
+				te.Errorf("f %+v is not %+v", f, validFloat64)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"f"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var f Float32
+			err := rs.Scan(&f)
+			if err != nil {
+				te.Errorf("Unable to scan Float32: %s", err)
+			}
+			if !f.Nil {
+				te.Errorf("f [%f] expected to be nil", f.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestFloatValue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validFloat64, validFloat32, nilFloat64).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (f)", validFloat64, validFloat32, nilFloat64)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestFloatJSONMarshal(t *testing.T) {
+	t.Run("marshal float", func(te *testing.T) {
+		result, err := validFloat64.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Float64 to JSON: %s", err)
+		}
+
+		if !bytes.Equal(result, testFloat64JSON) {
+			te.Errorf("Expected '%s', got '%s'", testFloat64JSON, result)
+		}
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilFloat64.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Float64 to JSON: %s", err)
+		}
+
+		if !bytes.Equal(result, testFloat64NilJSON) {
+			te.Errorf("Expected '%s', got '%s'", testFloat64NilJSON, result)
+		}
+	})
+
+	t.Run("marshal NaN", func(te *testing.T) {
+		f := Float64{Val: math.NaN()}
+		_, err := f.MarshalJSON()
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+		DefaultOptions.AllowNonFinite = true
+		defer func() { DefaultOptions.AllowNonFinite = false }()
+
+		result, err := f.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Float64 to JSON: %s", err)
+		}
+
+		if !bytes.Equal(result, testFloat64NaNJSON) {
+			te.Errorf("Expected '%s', got '%s'", testFloat64NaNJSON, result)
+		}
+	})
+}
+
+func TestFloatJSONUnmarshal(t *testing.T) {
+	type toCheck = struct {
+		b        []byte
+		expected Float64
+	}
+
+	checks := []toCheck{
+		toCheck{b: testFloat64JSON, expected: validFloat64},
+		toCheck{b: testFloat64MinusJSON, expected: validMinusFloat64},
+		toCheck{b: testFloat64StrJSON, expected: validFloat64},
+		toCheck{b: testFloat64NilJSON, expected: nilFloat64},
+		toCheck{b: []byte("2"), expected: Float64{Val: 2}},
+		toCheck{b: []byte(`"1e3"`), expected: Float64{Val: 1000}},
+		toCheck{b: []byte(`"abc"`), expected: Float64{Val: 0}},
+		toCheck{b: testFloat64NaNJSON, expected: Float64{Val: 0}},
+	}
+
+	for _, check := range checks {
+		var result Float64
+		err := result.UnmarshalJSON(check.b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.b, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, check.expected) {
+			t.Errorf("%s: result %+v not equal to %+v", check.b, result, check.expected)
+		}
+	}
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Float64
+		err := result.UnmarshalJSON(testFloat64ErrJSON)
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+	})
+
+	t.Run("unmarshal float32 out of range", func(te *testing.T) {
+		var result Float32
+		err := result.UnmarshalJSON([]byte("1e100"))
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxFloat32 {
+			te.Errorf("result %v is not %v", result.Val, float32(math.MaxFloat32))
+		}
+
+		err = result.UnmarshalJSONWith([]byte("1e100"), Options{Strict: true})
+		if !errors.Is(err, ErrOverflow) {
+			te.Errorf("Expected '%s', got '%v'", ErrOverflow, err)
+		}
+	})
+
+	t.Run("unmarshal non finite", func(te *testing.T) {
+		opts := Options{AllowNonFinite: true}
+		for _, s := range []string{`"NaN"`, `"Inf"`, `"+Inf"`, `"-Inf"`, `"infinity"`} {
+			var result Float64
+			err := result.UnmarshalJSONWith([]byte(s), opts)
+			if err != nil {
+				te.Errorf("%s: unexpected error: %s", s, err)
+			}
+
+			if !math.IsNaN(result.Val) && !math.IsInf(result.Val, 0) {
+				te.Errorf("%s: result %v is finite", s, result.Val)
+			}
+		}
+
+		var result Float64
+		err := result.UnmarshalJSONWith(testFloat64NaNJSON, Options{Strict: true})
+		if !errors.Is(err, ErrSyntax) {
+			te.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+		}
+	})
+
+	t.Run("strict precision", func(te *testing.T) {
+		var result Float32
+		err := result.ScanWith(int64(16777217), Options{Strict: true})
+		if !errors.Is(err, ErrTruncated) {
+			te.Errorf("Expected '%s', got '%v'", ErrTruncated, err)
+		}
+
+		err = result.ScanWith(int64(16777216), Options{Strict: true})
+		if err != nil || result.Val != 16777216 {
+			te.Errorf("result %+v, err: %v", result, err)
+		}
+	})
+}
+
+func TestFloatTextMarshal(t *testing.T) {
+	b, err := validFloat64.MarshalText()
+	if err != nil {
+		t.Errorf("Unexpected err: %s", err)
+	}
+
+	if !bytes.Equal(b, testFloat64ValidText) {
+		t.Errorf("b %s is not %s", b, testFloat64ValidText)
+	}
+
+	b, err = nilFloat64.MarshalText()
+	if err != nil {
+		t.Errorf("Unexpected err: %s", err)
+	}
+
+	if !bytes.Equal(b, testFloat64NilText) {
+		t.Errorf("b %s is not %s", b, testFloat64NilText)
+	}
+}
+
+func TestFloatTextUnmarshal(t *testing.T) {
+	var result Float64
+	err := result.UnmarshalText(testFloat64ValidText)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(result, validFloat64) {
+		t.Errorf("result %v not equal to %v", result, validFloat64)
+	}
+
+	err = result.UnmarshalText(testFloat64NilText)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(result, nilFloat64) {
+		t.Errorf("result %v not equal to %v", result, nilFloat64)
+	}
+}
@@ -1,5 +1,5 @@
 module github.com/ik5/extratypes
 
-go 1.14
+go 1.18
 
 require github.com/DATA-DOG/go-sqlmock v1.4.1
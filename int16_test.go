@@ -0,0 +1,339 @@
+package extratypes
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validInt16 = Int16{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMinusInt16 = Int16{
+		Val: -10,
+		Nil: false,
+	}
+
+	nilInt16 = Int16{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testInt16JSONError      = []byte("10")
+	testInt16MinusJSONError = []byte("-10")
+	testInt16NilJSONError   = []byte("null")
+	testInt16ErrJSONError   = []byte("a")
+	testInt16ValidText      = []byte("10")
+	testInt16MinuxValidText = []byte("-10")
+	testInt16NilText        = []byte("")
+	testInt16ErrText        = []byte("a")
+)
+
+func TestInt16String(t *testing.T) {
+	i := Int16{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Int16{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestInt16Scan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Int16
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Int16: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Int16
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Int16: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestInt16Value(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validInt16).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validInt16)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestInt16JSONMarshal(t *testing.T) {
+	t.Run("marshal int", func(te *testing.T) {
+		result, err := validInt16.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Int16 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt16JSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt16JSONError, result)
+		}
+
+	})
+
+	t.Run("marshal minus", func(te *testing.T) {
+		result, err := validMinusInt16.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Int16 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt16MinusJSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt16NilJSONError, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilInt16.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Int16 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt16NilJSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt16NilJSONError, result)
+		}
+
+	})
+}
+
+func TestInt16JSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal int", func(te *testing.T) {
+		var result Int16
+		err := result.UnmarshalJSON(testInt16JSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validInt16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validInt16)
+		}
+	})
+
+	t.Run("unmarshal minus int", func(te *testing.T) {
+		var result Int16
+		err := result.UnmarshalJSON(testInt16MinusJSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusInt16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusInt16)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Int16
+		err := result.UnmarshalJSON(testInt16NilJSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilInt16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt16)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Int16
+		err := result.UnmarshalJSON(testInt16ErrJSONError)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Int16
+		err := result.UnmarshalJSON([]byte("100000"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxInt16 {
+			te.Errorf("result %v is not %d", result, math.MaxInt16)
+		}
+	})
+}
+
+func TestInt16TextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validInt16.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt16ValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt16ValidText)
+		}
+	})
+
+	t.Run("text minus valid", func(te *testing.T) {
+		b, err := validMinusInt16.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt16MinuxValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt16MinuxValidText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilInt16.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt16NilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt16NilText)
+		}
+
+	})
+}
+func TestInt16TextUnmarshal(t *testing.T) {
+	t.Run("unmarshal int", func(te *testing.T) {
+		var result Int16
+		err := result.UnmarshalJSON(testInt16ValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validInt16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validInt16)
+		}
+	})
+
+	t.Run("unmarshal minus int", func(te *testing.T) {
+		var result Int16
+		err := result.UnmarshalText(testInt16MinuxValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusInt16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusInt16)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Int16
+		err := result.UnmarshalText(testInt16NilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilInt16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt16)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilInt16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt16)
+		}
+
+	})
+
+}
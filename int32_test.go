@@ -0,0 +1,339 @@
+package extratypes
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validInt32 = Int32{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMinusInt32 = Int32{
+		Val: -10,
+		Nil: false,
+	}
+
+	nilInt32 = Int32{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testInt32JSONError      = []byte("10")
+	testInt32MinusJSONError = []byte("-10")
+	testInt32NilJSONError   = []byte("null")
+	testInt32ErrJSONError   = []byte("a")
+	testInt32ValidText      = []byte("10")
+	testInt32MinuxValidText = []byte("-10")
+	testInt32NilText        = []byte("")
+	testInt32ErrText        = []byte("a")
+)
+
+func TestInt32String(t *testing.T) {
+	i := Int32{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Int32{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestInt32Scan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Int32
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Int32: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Int32
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Int32: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestInt32Value(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validInt32).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validInt32)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestInt32JSONMarshal(t *testing.T) {
+	t.Run("marshal int", func(te *testing.T) {
+		result, err := validInt32.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Int32 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt32JSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt32JSONError, result)
+		}
+
+	})
+
+	t.Run("marshal minus", func(te *testing.T) {
+		result, err := validMinusInt32.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Int32 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt32MinusJSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt32NilJSONError, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilInt32.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Int32 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt32NilJSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt32NilJSONError, result)
+		}
+
+	})
+}
+
+func TestInt32JSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal int", func(te *testing.T) {
+		var result Int32
+		err := result.UnmarshalJSON(testInt32JSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validInt32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validInt32)
+		}
+	})
+
+	t.Run("unmarshal minus int", func(te *testing.T) {
+		var result Int32
+		err := result.UnmarshalJSON(testInt32MinusJSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusInt32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusInt32)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Int32
+		err := result.UnmarshalJSON(testInt32NilJSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilInt32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt32)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Int32
+		err := result.UnmarshalJSON(testInt32ErrJSONError)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Int32
+		err := result.UnmarshalJSON([]byte("99999999999"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxInt32 {
+			te.Errorf("result %v is not %d", result, math.MaxInt32)
+		}
+	})
+}
+
+func TestInt32TextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validInt32.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt32ValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt32ValidText)
+		}
+	})
+
+	t.Run("text minus valid", func(te *testing.T) {
+		b, err := validMinusInt32.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt32MinuxValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt32MinuxValidText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilInt32.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt32NilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt32NilText)
+		}
+
+	})
+}
+func TestInt32TextUnmarshal(t *testing.T) {
+	t.Run("unmarshal int", func(te *testing.T) {
+		var result Int32
+		err := result.UnmarshalJSON(testInt32ValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validInt32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validInt32)
+		}
+	})
+
+	t.Run("unmarshal minus int", func(te *testing.T) {
+		var result Int32
+		err := result.UnmarshalText(testInt32MinuxValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusInt32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusInt32)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Int32
+		err := result.UnmarshalText(testInt32NilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilInt32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt32)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilInt32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt32)
+		}
+
+	})
+
+}
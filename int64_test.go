@@ -0,0 +1,339 @@
+package extratypes
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validInt64 = Int64{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMinusInt64 = Int64{
+		Val: -10,
+		Nil: false,
+	}
+
+	nilInt64 = Int64{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testInt64JSONError      = []byte("10")
+	testInt64MinusJSONError = []byte("-10")
+	testInt64NilJSONError   = []byte("null")
+	testInt64ErrJSONError   = []byte("a")
+	testInt64ValidText      = []byte("10")
+	testInt64MinuxValidText = []byte("-10")
+	testInt64NilText        = []byte("")
+	testInt64ErrText        = []byte("a")
+)
+
+func TestInt64String(t *testing.T) {
+	i := Int64{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Int64{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestInt64Scan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Int64
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Int64: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Int64
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Int64: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestInt64Value(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validInt64).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validInt64)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestInt64JSONMarshal(t *testing.T) {
+	t.Run("marshal int", func(te *testing.T) {
+		result, err := validInt64.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Int64 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt64JSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt64JSONError, result)
+		}
+
+	})
+
+	t.Run("marshal minus", func(te *testing.T) {
+		result, err := validMinusInt64.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Int64 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt64MinusJSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt64NilJSONError, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilInt64.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Int64 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt64NilJSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt64NilJSONError, result)
+		}
+
+	})
+}
+
+func TestInt64JSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal int", func(te *testing.T) {
+		var result Int64
+		err := result.UnmarshalJSON(testInt64JSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validInt64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validInt64)
+		}
+	})
+
+	t.Run("unmarshal minus int", func(te *testing.T) {
+		var result Int64
+		err := result.UnmarshalJSON(testInt64MinusJSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusInt64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusInt64)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Int64
+		err := result.UnmarshalJSON(testInt64NilJSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilInt64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt64)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Int64
+		err := result.UnmarshalJSON(testInt64ErrJSONError)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Int64
+		err := result.UnmarshalJSON([]byte("99999999999999999999"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxInt64 {
+			te.Errorf("result %v is not %d", result, math.MaxInt64)
+		}
+	})
+}
+
+func TestInt64TextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validInt64.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt64ValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt64ValidText)
+		}
+	})
+
+	t.Run("text minus valid", func(te *testing.T) {
+		b, err := validMinusInt64.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt64MinuxValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt64MinuxValidText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilInt64.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt64NilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt64NilText)
+		}
+
+	})
+}
+func TestInt64TextUnmarshal(t *testing.T) {
+	t.Run("unmarshal int", func(te *testing.T) {
+		var result Int64
+		err := result.UnmarshalJSON(testInt64ValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validInt64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validInt64)
+		}
+	})
+
+	t.Run("unmarshal minus int", func(te *testing.T) {
+		var result Int64
+		err := result.UnmarshalText(testInt64MinuxValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusInt64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusInt64)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Int64
+		err := result.UnmarshalText(testInt64NilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilInt64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt64)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilInt64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt64)
+		}
+
+	})
+
+}
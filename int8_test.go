@@ -0,0 +1,339 @@
+package extratypes
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validInt8 = Int8{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMinusInt8 = Int8{
+		Val: -10,
+		Nil: false,
+	}
+
+	nilInt8 = Int8{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testInt8JSONError      = []byte("10")
+	testInt8MinusJSONError = []byte("-10")
+	testInt8NilJSONError   = []byte("null")
+	testInt8ErrJSONError   = []byte("a")
+	testInt8ValidText      = []byte("10")
+	testInt8MinuxValidText = []byte("-10")
+	testInt8NilText        = []byte("")
+	testInt8ErrText        = []byte("a")
+)
+
+func TestInt8String(t *testing.T) {
+	i := Int8{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Int8{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestInt8Scan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Int8
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Int8: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Int8
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Int8: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestInt8Value(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validInt8).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validInt8)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestInt8JSONMarshal(t *testing.T) {
+	t.Run("marshal int", func(te *testing.T) {
+		result, err := validInt8.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Int8 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt8JSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt8JSONError, result)
+		}
+
+	})
+
+	t.Run("marshal minus", func(te *testing.T) {
+		result, err := validMinusInt8.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Int8 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt8MinusJSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt8NilJSONError, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilInt8.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Int8 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testInt8NilJSONError)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testInt8NilJSONError, result)
+		}
+
+	})
+}
+
+func TestInt8JSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal int", func(te *testing.T) {
+		var result Int8
+		err := result.UnmarshalJSON(testInt8JSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validInt8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validInt8)
+		}
+	})
+
+	t.Run("unmarshal minus int", func(te *testing.T) {
+		var result Int8
+		err := result.UnmarshalJSON(testInt8MinusJSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusInt8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusInt8)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Int8
+		err := result.UnmarshalJSON(testInt8NilJSONError)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilInt8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt8)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Int8
+		err := result.UnmarshalJSON(testInt8ErrJSONError)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Int8
+		err := result.UnmarshalJSON([]byte("1000"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxInt8 {
+			te.Errorf("result %v is not %d", result, math.MaxInt8)
+		}
+	})
+}
+
+func TestInt8TextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validInt8.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt8ValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt8ValidText)
+		}
+	})
+
+	t.Run("text minus valid", func(te *testing.T) {
+		b, err := validMinusInt8.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt8MinuxValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt8MinuxValidText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilInt8.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testInt8NilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testInt8NilText)
+		}
+
+	})
+}
+func TestInt8TextUnmarshal(t *testing.T) {
+	t.Run("unmarshal int", func(te *testing.T) {
+		var result Int8
+		err := result.UnmarshalJSON(testInt8ValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validInt8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validInt8)
+		}
+	})
+
+	t.Run("unmarshal minus int", func(te *testing.T) {
+		var result Int8
+		err := result.UnmarshalText(testInt8MinuxValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusInt8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusInt8)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Int8
+		err := result.UnmarshalText(testInt8NilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilInt8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt8)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilInt8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilInt8)
+		}
+
+	})
+
+}
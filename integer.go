@@ -0,0 +1,66 @@
+package extratypes
+
+import "database/sql/driver"
+
+// integer is the constraint of the type parameter of Integer
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Integer is a Null of an integer type, that also has arithmetic and
+// comparison where a nil operand gives nil, like SQL. Int, Uint and the
+// rest of their family are Integer of a specific T.
+type Integer[T integer] Null[T]
+
+func (n Integer[T]) String() string {
+	return Null[T](n).String()
+}
+
+// Value implements the driver Valuer interface.
+func (n Integer[T]) Value() (driver.Value, error) {
+	return Null[T](n).Value()
+}
+
+// Scan implements the Scanner interface.
+func (n *Integer[T]) Scan(v interface{}) error {
+	return (*Null[T])(n).Scan(v)
+}
+
+// ScanWith is like Scan, but converts v by the rules of opts
+func (n *Integer[T]) ScanWith(v interface{}, opts Options) error {
+	return (*Null[T])(n).ScanWith(v, opts)
+}
+
+// MarshalJSON implement the Marshaler interface
+func (n Integer[T]) MarshalJSON() ([]byte, error) {
+	return Null[T](n).MarshalJSON()
+}
+
+// UnmarshalJSON implement the un-Marshaler interface
+func (n *Integer[T]) UnmarshalJSON(b []byte) error {
+	return (*Null[T])(n).UnmarshalJSON(b)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but converts b by the rules of
+// opts
+func (n *Integer[T]) UnmarshalJSONWith(b []byte, opts Options) error {
+	return (*Null[T])(n).UnmarshalJSONWith(b, opts)
+}
+
+// MarshalText implement Text Marshaller interface
+func (n Integer[T]) MarshalText() ([]byte, error) {
+	return Null[T](n).MarshalText()
+}
+
+// UnmarshalText implement the text un-Marshaller interface.
+// An empty text, "null" and "nil" are all considered as nil.
+func (n *Integer[T]) UnmarshalText(b []byte) error {
+	return (*Null[T])(n).UnmarshalText(b)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but converts b by the rules of
+// opts
+func (n *Integer[T]) UnmarshalTextWith(b []byte, opts Options) error {
+	return (*Null[T])(n).UnmarshalTextWith(b, opts)
+}
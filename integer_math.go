@@ -0,0 +1,192 @@
+package extratypes
+
+// NilPolicy tells Sum and Avg what to do with a nil value
+type NilPolicy int
+
+// The available policies of nil values
+const (
+	// SkipNil ignores nil values, as SUM and AVG of SQL do
+	SkipNil NilPolicy = iota
+	// PropagateNil makes the result nil when one of the values is nil
+	PropagateNil
+)
+
+// Add returns n+m. It is nil when one of them is nil, and ErrOverflow is
+// returned when the result does not fit into T.
+func (n Integer[T]) Add(m Integer[T]) (Integer[T], error) {
+	if n.Nil || m.Nil {
+		return Integer[T]{Nil: true}, nil
+	}
+
+	r := n.Val + m.Val
+	if (m.Val > 0 && r < n.Val) || (m.Val < 0 && r > n.Val) {
+		return Integer[T]{}, ErrOverflow
+	}
+
+	return Integer[T]{Val: r}, nil
+}
+
+// Sub returns n-m. It is nil when one of them is nil, and ErrOverflow is
+// returned when the result does not fit into T, such as a negative result
+// of an unsigned T.
+func (n Integer[T]) Sub(m Integer[T]) (Integer[T], error) {
+	if n.Nil || m.Nil {
+		return Integer[T]{Nil: true}, nil
+	}
+
+	r := n.Val - m.Val
+	if (m.Val > 0 && r > n.Val) || (m.Val < 0 && r < n.Val) {
+		return Integer[T]{}, ErrOverflow
+	}
+
+	return Integer[T]{Val: r}, nil
+}
+
+// Mul returns n*m. It is nil when one of them is nil, and ErrOverflow is
+// returned when the result does not fit into T.
+func (n Integer[T]) Mul(m Integer[T]) (Integer[T], error) {
+	if n.Nil || m.Nil {
+		return Integer[T]{Nil: true}, nil
+	}
+
+	if n.Val == 0 || m.Val == 0 {
+		return Integer[T]{}, nil
+	}
+
+	r := n.Val * m.Val
+	if r/m.Val != n.Val || (r < 0) != ((n.Val < 0) != (m.Val < 0)) {
+		return Integer[T]{}, ErrOverflow
+	}
+
+	return Integer[T]{Val: r}, nil
+}
+
+// Div returns n/m truncated toward zero. It is nil when one of them is
+// nil, ErrDivisionByZero is returned when m is zero, and ErrOverflow when
+// the minimum of T is divided by -1.
+func (n Integer[T]) Div(m Integer[T]) (Integer[T], error) {
+	if n.Nil || m.Nil {
+		return Integer[T]{Nil: true}, nil
+	}
+
+	if m.Val == 0 {
+		return Integer[T]{}, ErrDivisionByZero
+	}
+
+	r := n.Val / m.Val
+	if r != 0 && (r < 0) != ((n.Val < 0) != (m.Val < 0)) {
+		return Integer[T]{}, ErrOverflow
+	}
+
+	return Integer[T]{Val: r}, nil
+}
+
+// Min returns the smaller of n and m, it is nil when one of them is nil.
+func (n Integer[T]) Min(m Integer[T]) Integer[T] {
+	if n.Nil || m.Nil {
+		return Integer[T]{Nil: true}
+	}
+
+	if m.Val < n.Val {
+		return Integer[T]{Val: m.Val}
+	}
+
+	return Integer[T]{Val: n.Val}
+}
+
+// Max returns the bigger of n and m, it is nil when one of them is nil.
+func (n Integer[T]) Max(m Integer[T]) Integer[T] {
+	if n.Nil || m.Nil {
+		return Integer[T]{Nil: true}
+	}
+
+	if m.Val > n.Val {
+		return Integer[T]{Val: m.Val}
+	}
+
+	return Integer[T]{Val: n.Val}
+}
+
+// Cmp returns -1 when n is smaller than m, 1 when n is bigger than m and
+// 0 when they are equal. A nil Integer is smaller than any other value, so
+// Cmp can be used for sorting.
+func (n Integer[T]) Cmp(m Integer[T]) int {
+	switch {
+	case n.Nil && m.Nil:
+		return 0
+	case n.Nil:
+		return -1
+	case m.Nil:
+		return 1
+	case n.Val < m.Val:
+		return -1
+	case n.Val > m.Val:
+		return 1
+	}
+
+	return 0
+}
+
+// Equal returns n = m by the three-valued logic of SQL, it is nil when
+// one of them is nil.
+func (n Integer[T]) Equal(m Integer[T]) Bool {
+	if n.Nil || m.Nil {
+		return boolNil
+	}
+
+	return boolOf(n.Val == m.Val)
+}
+
+// Sum returns the sum of values. It is nil when there are no values that
+// are not nil, or when one of them is nil and policy is PropagateNil.
+// ErrOverflow is returned when the sum does not fit into T.
+func Sum[T integer](values []Integer[T], policy NilPolicy) (Integer[T], error) {
+	sum := Integer[T]{Nil: true}
+	for _, v := range values {
+		if v.Nil {
+			if policy == PropagateNil {
+				return Integer[T]{Nil: true}, nil
+			}
+			continue
+		}
+
+		if sum.Nil {
+			sum = Integer[T]{Val: v.Val}
+			continue
+		}
+
+		var err error
+		sum, err = sum.Add(v)
+		if err != nil {
+			return Integer[T]{}, err
+		}
+	}
+
+	return sum, nil
+}
+
+// Avg returns the average of values, with its fraction. It is nil when
+// there are no values that are not nil, or when one of them is nil and
+// policy is PropagateNil. Nil values are not counted when they are
+// skipped.
+func Avg[T integer](values []Integer[T], policy NilPolicy) Float64 {
+	var sum float64
+	count := 0
+	for _, v := range values {
+		if v.Nil {
+			if policy == PropagateNil {
+				return Float64{Nil: true}
+			}
+			continue
+		}
+
+		sum += float64(v.Val)
+		count++
+	}
+
+	if count == 0 {
+		return Float64{Nil: true}
+	}
+
+	return Float64{Val: sum / float64(count)}
+}
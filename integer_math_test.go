@@ -0,0 +1,139 @@
+package extratypes
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestIntegerArithmetic(t *testing.T) {
+	type toCheck = struct {
+		name     string
+		fn       func() (interface{}, error)
+		expected interface{}
+		err      error
+	}
+
+	nilInt := Int{Nil: true}
+	checks := []toCheck{
+		toCheck{"add", func() (interface{}, error) { return Int{Val: 1}.Add(Int{Val: 2}) }, Int{Val: 3}, nil},
+		toCheck{"add nil", func() (interface{}, error) { return Int{Val: 1}.Add(nilInt) }, nilInt, nil},
+		toCheck{"add overflow", func() (interface{}, error) { return Int8{Val: 127}.Add(Int8{Val: 1}) }, Int8{}, ErrOverflow},
+		toCheck{"add underflow", func() (interface{}, error) { return Int8{Val: -128}.Add(Int8{Val: -1}) }, Int8{}, ErrOverflow},
+		toCheck{"add uint overflow", func() (interface{}, error) { return Uint8{Val: 255}.Add(Uint8{Val: 1}) }, Uint8{}, ErrOverflow},
+		toCheck{"sub", func() (interface{}, error) { return Int{Val: 1}.Sub(Int{Val: 3}) }, Int{Val: -2}, nil},
+		toCheck{"sub nil", func() (interface{}, error) { return nilInt.Sub(Int{Val: 3}) }, nilInt, nil},
+		toCheck{"sub overflow", func() (interface{}, error) { return Int8{Val: -128}.Sub(Int8{Val: 1}) }, Int8{}, ErrOverflow},
+		toCheck{"sub uint negative", func() (interface{}, error) { return Uint{Val: 1}.Sub(Uint{Val: 2}) }, Uint{}, ErrOverflow},
+		toCheck{"mul", func() (interface{}, error) { return Int16{Val: -3}.Mul(Int16{Val: 4}) }, Int16{Val: -12}, nil},
+		toCheck{"mul zero", func() (interface{}, error) { return Int16{Val: 0}.Mul(Int16{Val: 4}) }, Int16{Val: 0}, nil},
+		toCheck{"mul nil", func() (interface{}, error) { return Int16{Val: 0}.Mul(Int16{Nil: true}) }, Int16{Nil: true}, nil},
+		toCheck{"mul overflow", func() (interface{}, error) { return Int8{Val: 64}.Mul(Int8{Val: 2}) }, Int8{}, ErrOverflow},
+		toCheck{"mul min by -1", func() (interface{}, error) { return Int8{Val: -128}.Mul(Int8{Val: -1}) }, Int8{}, ErrOverflow},
+		toCheck{"mul uint overflow", func() (interface{}, error) { return Uint64{Val: math.MaxUint64}.Mul(Uint64{Val: 2}) }, Uint64{}, ErrOverflow},
+		toCheck{"div", func() (interface{}, error) { return Int{Val: -7}.Div(Int{Val: 2}) }, Int{Val: -3}, nil},
+		toCheck{"div nil", func() (interface{}, error) { return Int{Val: 7}.Div(nilInt) }, nilInt, nil},
+		toCheck{"div nil by zero", func() (interface{}, error) { return nilInt.Div(Int{Val: 0}) }, nilInt, nil},
+		toCheck{"div by zero", func() (interface{}, error) { return Int{Val: 7}.Div(Int{Val: 0}) }, Int{}, ErrDivisionByZero},
+		toCheck{"div min by -1", func() (interface{}, error) { return Int64{Val: math.MinInt64}.Div(Int64{Val: -1}) }, Int64{}, ErrOverflow},
+		toCheck{"div uint", func() (interface{}, error) { return Uint32{Val: 7}.Div(Uint32{Val: 2}) }, Uint32{Val: 3}, nil},
+	}
+
+	for _, check := range checks {
+		result, err := check.fn()
+		if !errors.Is(err, check.err) || (err == nil && check.err != nil) {
+			t.Errorf("%s: expected error '%v', got '%v'", check.name, check.err, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, check.expected) {
+			t.Errorf("%s: %+v is not %+v", check.name, result, check.expected)
+		}
+	}
+}
+
+func TestIntegerCompare(t *testing.T) {
+	one, two, nilInt := Int{Val: 1}, Int{Val: 2}, Int{Nil: true}
+
+	if !reflect.DeepEqual(one.Min(two), one) || !reflect.DeepEqual(two.Min(one), one) {
+		t.Errorf("Min of %s and %s is not %s", one, two, one)
+	}
+
+	if !reflect.DeepEqual(one.Max(two), two) || !reflect.DeepEqual(two.Max(one), two) {
+		t.Errorf("Max of %s and %s is not %s", one, two, two)
+	}
+
+	if !one.Min(nilInt).Nil || !nilInt.Max(two).Nil {
+		t.Errorf("Min and Max of nil are expected to be nil")
+	}
+
+	type toCheck = struct {
+		n, m     Int
+		cmp      int
+		expected Bool
+	}
+
+	checks := []toCheck{
+		toCheck{n: one, m: two, cmp: -1, expected: validBoolFalse},
+		toCheck{n: two, m: one, cmp: 1, expected: validBoolFalse},
+		toCheck{n: one, m: Int{Val: 1}, cmp: 0, expected: validBoolTrue},
+		toCheck{n: nilInt, m: one, cmp: -1, expected: validBoolNil},
+		toCheck{n: one, m: nilInt, cmp: 1, expected: validBoolNil},
+		toCheck{n: nilInt, m: nilInt, cmp: 0, expected: validBoolNil},
+	}
+
+	for _, check := range checks {
+		if cmp := check.n.Cmp(check.m); cmp != check.cmp {
+			t.Errorf("Cmp of %s and %s: %d is not %d", check.n, check.m, cmp, check.cmp)
+		}
+
+		if eq := check.n.Equal(check.m); !reflect.DeepEqual(eq, check.expected) {
+			t.Errorf("Equal of %s and %s: %s is not %s", check.n, check.m, eq, check.expected)
+		}
+	}
+}
+
+func TestIntegerSumAvg(t *testing.T) {
+	values := []Int64{Int64{Val: 1}, Int64{Nil: true}, Int64{Val: 2}, Int64{Val: 6}}
+
+	sum, err := Sum(values, SkipNil)
+	if err != nil || !reflect.DeepEqual(sum, Int64{Val: 9}) {
+		t.Errorf("sum %+v is not 9: %v", sum, err)
+	}
+
+	sum, err = Sum(values, PropagateNil)
+	if err != nil || !sum.Nil {
+		t.Errorf("sum %+v expected to be nil: %v", sum, err)
+	}
+
+	sum, err = Sum([]Int64{Int64{Nil: true}}, SkipNil)
+	if err != nil || !sum.Nil {
+		t.Errorf("sum %+v expected to be nil: %v", sum, err)
+	}
+
+	_, err = Sum([]Int8{Int8{Val: 100}, Int8{Val: 100}}, SkipNil)
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected '%s', got '%v'", ErrOverflow, err)
+	}
+
+	avg := Avg(values, SkipNil)
+	if !reflect.DeepEqual(avg, Float64{Val: 3}) {
+		t.Errorf("avg %+v is not 3", avg)
+	}
+
+	avg = Avg(values, PropagateNil)
+	if !avg.Nil {
+		t.Errorf("avg %+v expected to be nil", avg)
+	}
+
+	avg = Avg([]Uint8{Uint8{Val: 255}, Uint8{Val: 254}}, SkipNil)
+	if !reflect.DeepEqual(avg, Float64{Val: 254.5}) {
+		t.Errorf("avg %+v is not 254.5", avg)
+	}
+
+	avg = Avg([]Int{}, SkipNil)
+	if !avg.Nil {
+		t.Errorf("avg %+v expected to be nil", avg)
+	}
+}
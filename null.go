@@ -0,0 +1,130 @@
+package extratypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+)
+
+// Null contains a value of type T that can be null. The value can arrive
+// as a different type on JSON, Text and SQL, and it will be converted
+// into T by the same rules as the rest of the package.
+//
+// All the nullable types of the package (Int, Bool and the rest) are
+// Null with a specific T, or built on it, so they all handle nil in the
+// same way.
+type Null[T any] struct {
+	Val T
+	Nil bool
+}
+
+func (n Null[T]) String() string {
+	if n.Nil {
+		return "nil"
+	}
+
+	return asString(n.Val)
+}
+
+// Value implements the driver Valuer interface.
+func (n Null[T]) Value() (driver.Value, error) {
+	if n.Nil {
+		return nil, nil
+	}
+
+	return asDriverValue(n.Val)
+}
+
+// Scan implements the Scanner interface.
+func (n *Null[T]) Scan(v interface{}) error {
+	return n.ScanWith(v, DefaultOptions)
+}
+
+// ScanWith is like Scan, but converts v by the rules of opts
+func (n *Null[T]) ScanWith(v interface{}, opts Options) error {
+	var val T
+	isNil, err := opts.toType(v, &val)
+	if err != nil {
+		return err
+	}
+
+	n.Val = val
+	n.Nil = isNil
+	return nil
+}
+
+// MarshalJSON implement the Marshaler interface
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if n.Nil {
+		return json.Marshal(nil)
+	}
+
+	if s, ok := nonFiniteString(n.Val); ok && DefaultOptions.AllowNonFinite {
+		return json.Marshal(s)
+	}
+
+	return json.Marshal(n.Val)
+}
+
+// UnmarshalJSON implement the un-Marshaler interface
+func (n *Null[T]) UnmarshalJSON(b []byte) error {
+	return n.UnmarshalJSONWith(b, DefaultOptions)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but converts b by the rules of
+// opts
+func (n *Null[T]) UnmarshalJSONWith(b []byte, opts Options) error {
+	v, err := decodeJSON(b)
+	if err != nil {
+		return newConversionError(b, targetType(&n.Val), ReasonSyntax, err)
+	}
+
+	// a number is kept as it was written when T is a string, so an ID
+	// such as 12345678901234567890 does not pass through float64
+	if num, ok := v.(json.Number); ok && n.isString() {
+		return n.ScanWith(num.String(), opts)
+	}
+
+	return n.ScanWith(fromJSONNumber(v), opts)
+}
+
+// MarshalText implement Text Marshaller interface
+func (n Null[T]) MarshalText() ([]byte, error) {
+	if n.Nil {
+		return []byte(""), nil
+	}
+
+	return asByteSlice(n.String()), nil
+}
+
+// UnmarshalText implement the text un-Marshaller interface.
+// An empty text, "null" and "nil" are all considered as nil, unless T is
+// a string, where they are valid values, and only a nil slice is nil.
+func (n *Null[T]) UnmarshalText(b []byte) error {
+	return n.UnmarshalTextWith(b, DefaultOptions)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but converts b by the rules of
+// opts
+func (n *Null[T]) UnmarshalTextWith(b []byte, opts Options) error {
+	if n.isString() {
+		if b == nil {
+			return n.ScanWith(nil, opts)
+		}
+		return n.ScanWith(string(b), opts)
+	}
+
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) ||
+		bytes.Equal(b, []byte("nil")) {
+
+		return n.ScanWith(nil, opts)
+	}
+
+	return n.ScanWith(b, opts)
+}
+
+// isString reports whether T is a string type
+func (n *Null[T]) isString() bool {
+	return reflect.TypeOf(&n.Val).Elem().Kind() == reflect.String
+}
@@ -0,0 +1,96 @@
+package extratypes
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+)
+
+func TestNullScanNilResetsValue(t *testing.T) {
+	i := Int{Val: 10}
+	if err := i.Scan(nil); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(i, Int{Nil: true}) {
+		t.Errorf("i %+v is not nil with zero value", i)
+	}
+
+	b := Bool{Val: true}
+	if err := b.Scan(nil); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(b, Bool{Nil: true}) {
+		t.Errorf("b %+v is not nil with zero value", b)
+	}
+}
+
+func TestNullValue(t *testing.T) {
+	type toCheck = struct {
+		v        driver.Valuer
+		expected driver.Value
+	}
+
+	checks := []toCheck{
+		toCheck{v: Int{Val: 10}, expected: int64(10)},
+		toCheck{v: Int{Val: 10, Nil: true}, expected: nil},
+		toCheck{v: Int8{Val: -1}, expected: int64(-1)},
+		toCheck{v: Uint32{Val: 1}, expected: int64(1)},
+		toCheck{v: Uint64{Val: 1 << 63}, expected: "9223372036854775808"},
+		toCheck{v: Bool{Val: true}, expected: true},
+		toCheck{v: Bool{Val: true, Nil: true}, expected: nil},
+		toCheck{v: Null[string]{Val: "a"}, expected: "a"},
+	}
+
+	for _, check := range checks {
+		v, err := check.v.Value()
+		if err != nil {
+			t.Errorf("Unexpected error for %+v: %s", check.v, err)
+			continue
+		}
+
+		if v != check.expected {
+			t.Errorf("Expected (%T) %v, got (%T) %v", check.expected, check.expected, v, v)
+		}
+	}
+}
+
+func TestNullJSONUnmarshalResetsNil(t *testing.T) {
+	b := Bool{Nil: true}
+	if err := b.UnmarshalJSON([]byte("true")); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(b, validBoolTrue) {
+		t.Errorf("b %+v is not %+v", b, validBoolTrue)
+	}
+}
+
+func TestNullTextUnmarshalNil(t *testing.T) {
+	for _, text := range []string{"", "null", "nil"} {
+		i := Int{Val: 10}
+		if err := i.UnmarshalText([]byte(text)); err != nil {
+			t.Errorf("Unexpected error for '%s': %s", text, err)
+		}
+
+		if !i.Nil {
+			t.Errorf("'%s' expected to be nil", text)
+		}
+	}
+}
+
+func TestNullString(t *testing.T) {
+	checks := map[string]interface{ String() string }{
+		"10":    Int{Val: 10},
+		"nil":   Uint{Nil: true},
+		"true":  Bool{Val: true},
+		"hello": Null[string]{Val: "hello"},
+	}
+
+	for expected, v := range checks {
+		if v.String() != expected {
+			t.Errorf("'%s' is not '%s'", v, expected)
+		}
+	}
+}
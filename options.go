@@ -0,0 +1,37 @@
+package extratypes
+
+// Options holds the rules that are used when a value is converted into
+// the type that holds it.
+type Options struct {
+	// Strict makes a conversion that would lose information return an
+	// error, instead of clamping the value into range, dropping the
+	// fraction of a float or turning a string that cannot be parsed into 0.
+	Strict bool
+
+	// AllowNonFinite accepts NaN and infinity (including the strings
+	// "NaN", "Inf", "+Inf" and "-Inf") as the value of a float.
+	// JSON cannot hold them as numbers, so they are marshaled as these
+	// strings. When false, they are handled as a value that cannot be
+	// parsed.
+	AllowNonFinite bool
+
+	// BoolVocabulary holds the words that a bool is read from. When nil,
+	// DefaultBoolVocabulary is used. The words that a BoolFormat writes
+	// (true/false, Y/N, 1/0 and yes/no) are read even when they are not
+	// in it.
+	BoolVocabulary BoolVocabulary
+
+	// RejectUnknownBool makes a word that is not in the vocabulary return
+	// an error, instead of becoming false.
+	RejectUnknownBool bool
+
+	// Slice holds the rules of a SlicedString that is read from a single
+	// text.
+	Slice SliceOptions
+}
+
+// DefaultOptions are the options that Scan, UnmarshalJSON and
+// UnmarshalText of the types in the package use. Changing them affects
+// the whole package, for a single call use the "With" variants, such as
+// Null.ScanWith.
+var DefaultOptions = Options{}
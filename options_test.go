@@ -0,0 +1,232 @@
+package extratypes
+
+import (
+	"errors"
+	"testing"
+)
+
+var strictOptions = Options{Strict: true}
+
+func TestStrictInt(t *testing.T) {
+	type toCheck = struct {
+		src      interface{}
+		expected int32
+		err      error
+	}
+
+	checks := []toCheck{
+		toCheck{src: 10, expected: 10},
+		toCheck{src: int64(99999999999), err: ErrOverflow},
+		toCheck{src: int64(-99999999999), err: ErrOverflow},
+		toCheck{src: uint64(99999999999), err: ErrOverflow},
+		toCheck{src: 10.0, expected: 10},
+		toCheck{src: 10.5, err: ErrTruncated},
+		toCheck{src: 1e30, err: ErrOverflow},
+		toCheck{src: "-10", expected: -10},
+		toCheck{src: "10.0", expected: 10},
+		toCheck{src: "10.5", err: ErrTruncated},
+		toCheck{src: "99999999999", err: ErrOverflow},
+		toCheck{src: "99999999999999999999", err: ErrOverflow},
+		toCheck{src: "abc", err: ErrSyntax},
+		toCheck{src: "12x", err: ErrSyntax},
+		toCheck{src: "", err: ErrSyntax},
+		toCheck{src: []byte("12"), expected: 12},
+		toCheck{src: true, err: ErrUnsupported},
+	}
+
+	for _, check := range checks {
+		var dest int32
+		_, err := strictOptions.toType(check.src, &dest)
+		if check.err != nil {
+			if !errors.Is(err, check.err) {
+				t.Errorf("%#v: expected error '%s', got '%v'", check.src, check.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%#v: unexpected error: %s", check.src, err)
+			continue
+		}
+
+		if dest != check.expected {
+			t.Errorf("%#v: dest %d is not %d", check.src, dest, check.expected)
+		}
+	}
+}
+
+func TestStrictUint(t *testing.T) {
+	type toCheck = struct {
+		src      interface{}
+		expected uint8
+		err      error
+	}
+
+	checks := []toCheck{
+		toCheck{src: 10, expected: 10},
+		toCheck{src: -1, err: ErrNegative},
+		toCheck{src: -1.5, err: ErrNegative},
+		toCheck{src: "-1", err: ErrNegative},
+		toCheck{src: 256, err: ErrOverflow},
+		toCheck{src: uint64(256), err: ErrOverflow},
+		toCheck{src: 1.5, err: ErrTruncated},
+		toCheck{src: "255", expected: 255},
+		toCheck{src: "256", err: ErrOverflow},
+		toCheck{src: "x1", err: ErrSyntax},
+		toCheck{src: struct{}{}, err: ErrUnsupported},
+	}
+
+	for _, check := range checks {
+		var dest uint8
+		_, err := strictOptions.toType(check.src, &dest)
+		if check.err != nil {
+			if !errors.Is(err, check.err) {
+				t.Errorf("%#v: expected error '%s', got '%v'", check.src, check.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%#v: unexpected error: %s", check.src, err)
+			continue
+		}
+
+		if dest != check.expected {
+			t.Errorf("%#v: dest %d is not %d", check.src, dest, check.expected)
+		}
+	}
+}
+
+func TestStrictBool(t *testing.T) {
+	type toCheck = struct {
+		src      interface{}
+		expected bool
+		err      error
+	}
+
+	checks := []toCheck{
+		toCheck{src: true, expected: true},
+		toCheck{src: "yes", expected: true},
+		toCheck{src: "F", expected: false},
+		toCheck{src: []byte("n"), expected: false},
+		toCheck{src: 1, expected: true},
+		toCheck{src: uint8(0), expected: false},
+		toCheck{src: 1.0, expected: true},
+		toCheck{src: 0.0, expected: false},
+		toCheck{src: "maybe", err: ErrSyntax},
+		toCheck{src: "treu", err: ErrSyntax},
+		toCheck{src: "2", err: ErrSyntax},
+		toCheck{src: 2, err: ErrOverflow},
+		toCheck{src: -2, err: ErrOverflow},
+		toCheck{src: uint(2), err: ErrOverflow},
+		toCheck{src: 0.9, err: ErrTruncated},
+		toCheck{src: 1.5, err: ErrOverflow},
+		toCheck{src: struct{}{}, err: ErrUnsupported},
+	}
+
+	for _, check := range checks {
+		var dest bool
+		_, err := strictOptions.toType(check.src, &dest)
+		if check.err != nil {
+			if !errors.Is(err, check.err) {
+				t.Errorf("%#v: expected error '%s', got '%v'", check.src, check.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%#v: unexpected error: %s", check.src, err)
+			continue
+		}
+
+		if dest != check.expected {
+			t.Errorf("%#v: dest %t is not %t", check.src, dest, check.expected)
+		}
+	}
+
+	t.Run("bool", func(te *testing.T) {
+		var b Bool
+		err := b.UnmarshalJSONWith([]byte(`"maybe"`), strictOptions)
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || convErr.Reason != ReasonSyntax {
+			te.Errorf("Expected ConversionError with syntax reason, got '%v'", err)
+		}
+
+		err = b.UnmarshalJSONWith([]byte(`2`), strictOptions)
+		if !errors.Is(err, ErrOverflow) {
+			te.Errorf("Expected '%s', got '%v'", ErrOverflow, err)
+		}
+
+		err = b.UnmarshalTextWith([]byte("treu"), strictOptions)
+		if !errors.Is(err, ErrSyntax) {
+			te.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+		}
+
+		err = b.ScanWith(0.9, strictOptions)
+		if !errors.Is(err, ErrTruncated) {
+			te.Errorf("Expected '%s', got '%v'", ErrTruncated, err)
+		}
+
+		err = b.UnmarshalTextWith([]byte("null"), strictOptions)
+		if err != nil || !b.Nil {
+			te.Errorf("Expected nil without error, got %+v: %v", b, err)
+		}
+
+		err = b.UnmarshalJSON([]byte(`"maybe"`))
+		if err != nil || b.Val || b.Nil {
+			te.Errorf("Expected false without error, got %+v: %v", b, err)
+		}
+	})
+}
+
+func TestStrictNull(t *testing.T) {
+	t.Run("unmarshal JSON", func(te *testing.T) {
+		var i Int32
+		err := i.UnmarshalJSONWith([]byte(`"99999999999"`), strictOptions)
+		if !errors.Is(err, ErrOverflow) {
+			te.Errorf("Expected '%s', got '%v'", ErrOverflow, err)
+		}
+
+		var i64 Int64
+		err = i64.UnmarshalJSONWith([]byte(`9007199254740993`), strictOptions)
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if i64.Val != 9007199254740993 {
+			te.Errorf("i64 %d lost precision", i64.Val)
+		}
+	})
+
+	t.Run("unmarshal text", func(te *testing.T) {
+		var i Uint
+		err := i.UnmarshalTextWith([]byte("-1"), strictOptions)
+		if !errors.Is(err, ErrNegative) {
+			te.Errorf("Expected '%s', got '%v'", ErrNegative, err)
+		}
+
+		err = i.UnmarshalTextWith(nil, strictOptions)
+		if err != nil || !i.Nil {
+			te.Errorf("Expected nil without error, got %+v: %v", i, err)
+		}
+	})
+
+	t.Run("scan", func(te *testing.T) {
+		var i Int
+		err := i.ScanWith([]byte("12x"), strictOptions)
+		if !errors.Is(err, ErrSyntax) {
+			te.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+		}
+	})
+
+	t.Run("package wide", func(te *testing.T) {
+		DefaultOptions.Strict = true
+		defer func() { DefaultOptions.Strict = false }()
+
+		var i Int8
+		err := i.UnmarshalJSON([]byte("1000"))
+		if !errors.Is(err, ErrOverflow) {
+			te.Errorf("Expected '%s', got '%v'", ErrOverflow, err)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package extratypes
+
+import (
+	"reflect"
+	"sync"
+)
+
+// converterFunc converts src into dest, where dest is a pointer to the
+// type the converter was registered for
+type converterFunc func(src, dest interface{}) error
+
+var (
+	convertersLock sync.RWMutex
+	converters     = map[reflect.Type]converterFunc{}
+)
+
+// RegisterConverter registers fn as the way to convert a value into T.
+// Every conversion into T made by the package, such as Convert, ConvertTo
+// and the Scan and Unmarshal methods of Null[T], calls fn with any non nil
+// source value instead of using the built-in rules.
+//
+// An error returned by fn is reported as a *ConversionError. If the error
+// is one of the reason errors (such as ErrOverflow) it is used as the
+// reason, otherwise the reason is ReasonSyntax.
+//
+// Registering a converter for a type that already has one replaces it.
+// It is safe to call RegisterConverter from multiple goroutines, but
+// converters are usually registered on init.
+func RegisterConverter[T any](fn func(src interface{}, dest *T) error) {
+	convertersLock.Lock()
+	defer convertersLock.Unlock()
+
+	converters[reflect.TypeOf((*T)(nil)).Elem()] = func(src, dest interface{}) error {
+		return fn(src, dest.(*T))
+	}
+}
+
+// UnregisterConverter removes the converter of T, and return the
+// conversion into T to the built-in rules.
+func UnregisterConverter[T any]() {
+	convertersLock.Lock()
+	defer convertersLock.Unlock()
+
+	delete(converters, reflect.TypeOf((*T)(nil)).Elem())
+}
+
+func lookupConverter(t reflect.Type) (converterFunc, bool) {
+	if t == nil {
+		return nil, false
+	}
+
+	convertersLock.RLock()
+	defer convertersLock.RUnlock()
+
+	converter, ok := converters[t]
+	return converter, ok
+}
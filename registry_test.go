@@ -0,0 +1,102 @@
+package extratypes
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type testColor int
+
+const (
+	testColorRed testColor = iota + 1
+	testColorGreen
+)
+
+type testID int64
+
+func convertTestColor(src interface{}, dest *testColor) error {
+	switch strings.ToLower(asString(src)) {
+	case "red", "1":
+		*dest = testColorRed
+	case "green", "2":
+		*dest = testColorGreen
+	case "blue":
+		return ErrUnsupported
+	default:
+		return fmt.Errorf("unknown color")
+	}
+
+	return nil
+}
+
+func TestRegisterConverter(t *testing.T) {
+	RegisterConverter(convertTestColor)
+	defer UnregisterConverter[testColor]()
+
+	t.Run("convert", func(te *testing.T) {
+		var c testColor
+		isNil, err := Convert("Green", &c)
+		if err != nil || isNil || c != testColorGreen {
+			te.Errorf("c: %d, isNil: %t, err: %v", c, isNil, err)
+		}
+	})
+
+	t.Run("null", func(te *testing.T) {
+		var c Null[testColor]
+		err := c.UnmarshalJSON([]byte(`"red"`))
+		if err != nil || c.Nil || c.Val != testColorRed {
+			te.Errorf("c: %+v, err: %v", c, err)
+		}
+
+		err = c.UnmarshalJSON([]byte(`null`))
+		if err != nil || !c.Nil {
+			te.Errorf("c: %+v, err: %v", c, err)
+		}
+
+		err = c.Scan(int64(2))
+		if err != nil || c.Nil || c.Val != testColorGreen {
+			te.Errorf("c: %+v, err: %v", c, err)
+		}
+	})
+
+	t.Run("errors", func(te *testing.T) {
+		var c testColor
+		_, err := Convert("purple", &c)
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || convErr.Reason != ReasonSyntax {
+			te.Errorf("Expected ConversionError with syntax reason, got %v", err)
+		}
+
+		_, err = Convert("blue", &c)
+		if !errors.Is(err, ErrUnsupported) {
+			te.Errorf("Expected '%s', got %v", ErrUnsupported, err)
+		}
+	})
+}
+
+func TestUnregisterConverter(t *testing.T) {
+	RegisterConverter(convertTestColor)
+	UnregisterConverter[testColor]()
+
+	var c testColor
+	_, err := Convert("7", &c)
+	if err != nil || c != 7 {
+		t.Errorf("c: %d, err: %v", c, err)
+	}
+}
+
+func TestConvertNamedTypes(t *testing.T) {
+	var id testID
+	_, err := Convert("99", &id)
+	if err != nil || id != 99 {
+		t.Errorf("id: %d, err: %v", id, err)
+	}
+
+	var n Null[testID]
+	err = n.UnmarshalJSON([]byte(`"100"`))
+	if err != nil || n.Val != 100 {
+		t.Errorf("n: %+v, err: %v", n, err)
+	}
+}
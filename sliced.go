@@ -0,0 +1,310 @@
+package extratypes
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+// Sliced is like SlicedString for other types: it takes either a single
+// value or an array of values (5 and [5, "6"]) on JSON, Text and SQL,
+// and converts each element into T by the same rules as Null[T], but in
+// strict mode and with RejectUnknownBool. An element that cannot be
+// converted, such as "x" for an int or "maybe" for a bool, returns an
+// *ElementError with its index. SliceOptions.Lenient turns it into the
+// zero value of T instead, as Null[T] does.
+//
+// It is written in SlicedJSONFormat, SlicedTextFormat and SlicedSQLFormat,
+// where each element is written as its Go value, so a time.Duration is
+// nanoseconds.
+type Sliced[T any] []T
+
+// Slices of the common types
+type (
+	// SlicedInt is a Sliced of int
+	SlicedInt = Sliced[int]
+	// SlicedBool is a Sliced of bool, that are read by the bool
+	// vocabulary
+	SlicedBool = Sliced[bool]
+	// SlicedDuration is a Sliced of time.Duration, that are read from a
+	// string as a Duration does, or from a number of nanoseconds
+	SlicedDuration = Sliced[time.Duration]
+)
+
+// Scan implements the Scanner interface. A string is read in
+// SlicedSQLFormat, and a PostgreSQL array literal, such as {1,2,NULL}, is
+// read as its elements.
+func (s *Sliced[T]) Scan(value interface{}) error {
+	return s.ScanWith(value, DefaultOptions)
+}
+
+// ScanWith is like Scan, but converts value by the rules of opts
+func (s *Sliced[T]) ScanWith(value interface{}, opts Options) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+
+	if b, ok := value.([]byte); ok {
+		value = string(b)
+	}
+
+	if str, ok := value.(string); ok {
+		var err error
+		value, err = s.fromSQL(str, opts)
+		if err != nil {
+			return err
+		}
+
+		if value == nil {
+			*s = nil
+			return nil
+		}
+	}
+
+	return s.set(value, opts)
+}
+
+// fromSQL returns the elements of str, that was written in
+// SlicedSQLFormat, as a single value or a slice for set. A string that is
+// not in the format is a single value, and nil is a nil slice.
+func (s *Sliced[T]) fromSQL(str string, opts Options) (interface{}, error) {
+	// a PostgreSQL array arrives as an array literal whatever the format
+	// is
+	if isPostgresArray(str) {
+		return s.fromText(str, SlicedPostgres, opts)
+	}
+
+	switch {
+	case SlicedSQLFormat == SlicedJoined || opts.Slice.Split:
+		return s.fromText(str, SlicedJoined, opts)
+
+	case SlicedSQLFormat == SlicedArray || SlicedSQLFormat == SlicedSingle:
+		if v, err := decodeJSON([]byte(str)); err == nil && v != nil {
+			return fromJSONNumber(v), nil
+		}
+	}
+
+	return str, nil
+}
+
+// fromText returns the elements of str, that was written in the text
+// format f, as a slice for set. An empty joined text is a nil slice.
+func (s *Sliced[T]) fromText(str string, f SlicedFormat, opts Options) (interface{}, error) {
+	if f == SlicedPostgres {
+		elems, err := parsePostgresArray(str)
+		if err != nil {
+			return nil, asConversionError(str, targetType(s), ReasonSyntax, err)
+		}
+		return elems, nil
+	}
+
+	if str == "" {
+		return nil, nil
+	}
+
+	elems, err := opts.Slice.split(str)
+	if err != nil {
+		return nil, asConversionError(str, targetType(s), ReasonSyntax, err)
+	}
+	return []string(elems), nil
+}
+
+// UnmarshalJSON implement the un-Marshaler interface
+func (s *Sliced[T]) UnmarshalJSON(data []byte) error {
+	return s.UnmarshalJSONWith(data, DefaultOptions)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but converts data by the rules
+// of opts
+func (s *Sliced[T]) UnmarshalJSONWith(data []byte, opts Options) error {
+	v, err := decodeJSON(data)
+	if err != nil {
+		return newConversionError(data, targetType(s), ReasonSyntax, err)
+	}
+
+	// a text format is written as a JSON string, that is read back in
+	// the same format
+	if str, ok := v.(string); ok && SlicedJSONFormat.isText() {
+		v, err = s.fromText(str, SlicedJSONFormat, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	if v == nil {
+		*s = nil
+		return nil
+	}
+
+	return s.set(fromJSONNumber(v), opts)
+}
+
+// UnmarshalText implement the text un-Marshaller interface, it reads the
+// text in SlicedTextFormat. An empty text is a nil slice.
+func (s *Sliced[T]) UnmarshalText(data []byte) error {
+	return s.UnmarshalTextWith(data, DefaultOptions)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but converts data by the rules
+// of opts
+func (s *Sliced[T]) UnmarshalTextWith(data []byte, opts Options) error {
+	if len(data) == 0 {
+		*s = nil
+		return nil
+	}
+
+	if SlicedTextFormat.isText() {
+		elems, err := s.fromText(string(data), SlicedTextFormat, opts)
+		if err != nil {
+			return err
+		}
+		return s.set(elems, opts)
+	}
+
+	return s.UnmarshalJSONWith(data, opts)
+}
+
+// Value implements the driver Valuer interface, in SlicedSQLFormat.
+// A nil slice is NULL.
+func (s Sliced[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	b, err := s.format(SlicedSQLFormat, DefaultOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// MarshalJSON implement the Marshaler interface, in SlicedJSONFormat.
+// A nil slice is null.
+func (s Sliced[T]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return json.Marshal(nil)
+	}
+
+	if SlicedJSONFormat.isText() {
+		b, err := s.format(SlicedJSONFormat, DefaultOptions)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(b))
+	}
+
+	return s.format(SlicedJSONFormat, DefaultOptions)
+}
+
+// MarshalText implement Text Marshaller interface, in SlicedTextFormat.
+// A nil slice is an empty text.
+func (s Sliced[T]) MarshalText() ([]byte, error) {
+	if s == nil {
+		return []byte(""), nil
+	}
+
+	return s.format(SlicedTextFormat, DefaultOptions)
+}
+
+// format writes s in f, by the rules of opts
+func (s Sliced[T]) format(f SlicedFormat, opts Options) ([]byte, error) {
+	if f.isText() {
+		strs := make(SlicedString, 0, len(s))
+		for _, v := range s {
+			strs = append(strs, asString(v))
+		}
+		return strs.format(f, opts)
+	}
+
+	if f == SlicedSingle && len(s) == 1 {
+		return json.Marshal(s[0])
+	}
+
+	// a nil slice is written as an empty array and not as null
+	return json.Marshal(append([]T{}, s...))
+}
+
+// set converts v, that is a single value or an array, into s
+func (s *Sliced[T]) set(v interface{}, opts Options) error {
+	opts = opts.elementOptions()
+	items := reflect.ValueOf(v)
+	if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+		var val T
+		_, err := opts.toType(v, &val)
+		if err != nil {
+			return err
+		}
+
+		*s = Sliced[T]{val}
+		return nil
+	}
+
+	result := make(Sliced[T], 0, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		var err error
+		result, err = appendElement(result, items.Index(i), opts)
+		if err != nil {
+			return &ElementError{Index: i, Err: err}
+		}
+	}
+
+	*s = result
+	return nil
+}
+
+// appendElement converts item into T and appends it to result. A null
+// item follows the Null policy of opts, where KeepNull is the zero value
+// of T, and a nested array is flattened by its Flatten.
+func appendElement[T any](result Sliced[T], item reflect.Value, opts Options) (Sliced[T], error) {
+	var val T
+
+	if item.Kind() == reflect.Interface || item.Kind() == reflect.Ptr {
+		if item.IsNil() {
+			switch opts.Slice.Null {
+			case KeepNull:
+				result = append(result, val)
+			case RejectNull:
+				return nil, newConversionError(nil, targetType(&val), ReasonNilNotAllowed, nil)
+			}
+			return result, nil
+		}
+		item = item.Elem()
+	}
+
+	_, isBytes := item.Interface().([]byte)
+	if (item.Kind() == reflect.Slice || item.Kind() == reflect.Array) && !isBytes {
+		if !opts.Slice.Flatten {
+			return nil, newConversionError(item.Interface(), targetType(&val), ReasonUnsupported, nil)
+		}
+
+		for i := 0; i < item.Len(); i++ {
+			var err error
+			result, err = appendElement(result, item.Index(i), opts)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	_, err := opts.toType(item.Interface(), &val)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(result, val), nil
+}
+
+// elementOptions returns the options that the elements of a Sliced are
+// converted by, that are strict unless o.Slice is Lenient
+func (o Options) elementOptions() Options {
+	if !o.Slice.Lenient {
+		o.Strict = true
+		o.RejectUnknownBool = true
+	}
+
+	return o
+}
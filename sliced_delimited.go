@@ -0,0 +1,137 @@
+package extratypes
+
+import "strings"
+
+// EmptyPolicy tells what to do with an empty element of a delimited text
+type EmptyPolicy int
+
+// The available policies of empty elements
+const (
+	// KeepEmpty keeps an empty element as an empty string, so "a,,b" is
+	// three elements
+	KeepEmpty EmptyPolicy = iota
+	// SkipEmpty drops an empty element, so "a,,b" is two elements
+	SkipEmpty
+	// RejectEmpty returns an error with ReasonSyntax on an empty element
+	RejectEmpty
+)
+
+// delimiter returns the delimiter of o, or its default
+func (o SliceOptions) delimiter() string {
+	if o.Delimiter == "" {
+		return ","
+	}
+
+	return o.Delimiter
+}
+
+// trimLeft removes the spaces at the start of s when o trims them
+func (o SliceOptions) trimLeft(s string) string {
+	if !o.TrimSpace {
+		return s
+	}
+
+	return strings.TrimLeft(s, " \t\r\n")
+}
+
+// split splits s into its elements by the rules of o
+func (o SliceOptions) split(s string) (SlicedString, error) {
+	delim := o.delimiter()
+	var result SlicedString
+
+	for {
+		var elem string
+		quoted := false
+
+		if start := o.trimLeft(s); o.Quoted && strings.HasPrefix(start, `"`) {
+			var err error
+			elem, s, err = unquoteCSV(start[1:])
+			if err != nil {
+				return nil, err
+			}
+
+			s = o.trimLeft(s)
+			if s != "" && !strings.HasPrefix(s, delim) {
+				return nil, ErrSyntax
+			}
+			quoted = true
+		} else {
+			i := strings.Index(s, delim)
+			if i < 0 {
+				i = len(s)
+			}
+
+			elem, s = s[:i], s[i:]
+			if o.TrimSpace {
+				elem = strings.TrimSpace(elem)
+			}
+		}
+
+		switch {
+		case elem != "" || quoted || o.Empty == KeepEmpty:
+			result = append(result, elem)
+		case o.Empty == RejectEmpty:
+			return nil, ErrSyntax
+		}
+
+		if s == "" {
+			return result, nil
+		}
+		s = s[len(delim):]
+	}
+}
+
+// unquoteCSV reads a quoted element of CSV, where s is the text after the
+// opening quote. It returns the element and the text after the closing
+// quote.
+func unquoteCSV(s string) (string, string, error) {
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(s, '"')
+		if i < 0 {
+			return "", "", ErrSyntax
+		}
+
+		b.WriteString(s[:i])
+		s = s[i+1:]
+		if !strings.HasPrefix(s, `"`) {
+			return b.String(), s, nil
+		}
+
+		b.WriteByte('"')
+		s = s[1:]
+	}
+}
+
+// join joins the elements of s by the rules of o, so split reads them
+// back. Without Quoted, an element that has the delimiter cannot be read
+// back as a single element.
+func (o SliceOptions) join(s SlicedString) string {
+	if !o.Quoted {
+		return strings.Join(s, o.delimiter())
+	}
+
+	elems := make([]string, len(s))
+	for i, elem := range s {
+		if o.needsQuote(elem) {
+			elem = `"` + strings.ReplaceAll(elem, `"`, `""`) + `"`
+		}
+		elems[i] = elem
+	}
+
+	return strings.Join(elems, o.delimiter())
+}
+
+// needsQuote reports whether elem must be quoted to be read back by o as
+// it is
+func (o SliceOptions) needsQuote(elem string) bool {
+	if elem == "" {
+		return o.Empty != KeepEmpty
+	}
+
+	if strings.Contains(elem, o.delimiter()) || strings.ContainsAny(elem, "\"\r\n") {
+		return true
+	}
+
+	return o.TrimSpace && strings.TrimSpace(elem) != elem
+}
@@ -0,0 +1,132 @@
+package extratypes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSliceOptionsSplit(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		opts     SliceOptions
+		expected SlicedString
+		err      error
+	}
+
+	csv := SliceOptions{Quoted: true}
+	trimmed := SliceOptions{Delimiter: ";", TrimSpace: true, Quoted: true}
+
+	checks := []toCheck{
+		toCheck{s: "a,b,c", expected: SlicedString{"a", "b", "c"}},
+		toCheck{s: "a, b ,c", expected: SlicedString{"a", " b ", "c"}},
+		toCheck{s: "a, b ,c", opts: SliceOptions{TrimSpace: true}, expected: SlicedString{"a", "b", "c"}},
+		toCheck{s: "a; b; c", opts: SliceOptions{Delimiter: "; "}, expected: SlicedString{"a", "b", "c"}},
+		toCheck{s: "a,,b,", expected: SlicedString{"a", "", "b", ""}},
+		toCheck{s: "a,,b,", opts: SliceOptions{Empty: SkipEmpty}, expected: SlicedString{"a", "b"}},
+		toCheck{s: "a, ,b", opts: SliceOptions{Empty: RejectEmpty, TrimSpace: true}, err: ErrSyntax},
+		toCheck{s: `"a"`, expected: SlicedString{`"a"`}},
+		toCheck{s: `"a,b",c`, opts: csv, expected: SlicedString{"a,b", "c"}},
+		toCheck{s: `"say ""hi""",""`, opts: csv, expected: SlicedString{`say "hi"`, ""}},
+		toCheck{s: `,""`, opts: SliceOptions{Quoted: true, Empty: SkipEmpty}, expected: SlicedString{""}},
+		toCheck{s: `a"b,c`, opts: csv, expected: SlicedString{`a"b`, "c"}},
+		toCheck{s: "\"line\nbreak\",x", opts: csv, expected: SlicedString{"line\nbreak", "x"}},
+		toCheck{s: ` "a; b" ; c `, opts: trimmed, expected: SlicedString{"a; b", "c"}},
+		toCheck{s: `"a`, opts: csv, err: ErrSyntax},
+		toCheck{s: `"a" ,b`, opts: csv, err: ErrSyntax},
+		toCheck{s: `"a"b`, opts: csv, err: ErrSyntax},
+	}
+
+	for _, check := range checks {
+		result, err := check.opts.split(check.s)
+		if check.err != nil {
+			if !errors.Is(err, check.err) {
+				t.Errorf("%q: expected '%s', got '%v'", check.s, check.err, err)
+			}
+			continue
+		}
+
+		if err != nil || !reflect.DeepEqual(result, check.expected) {
+			t.Errorf("%q gave %#v, expected %#v: %v", check.s, result, check.expected, err)
+		}
+	}
+}
+
+func TestSliceOptionsJoin(t *testing.T) {
+	type toCheck = struct {
+		s        SlicedString
+		opts     SliceOptions
+		expected string
+	}
+
+	checks := []toCheck{
+		toCheck{s: SlicedString{"a", "b,c"}, expected: "a,b,c"},
+		toCheck{s: SlicedString{"a", "b,c"}, opts: SliceOptions{Quoted: true}, expected: `a,"b,c"`},
+		toCheck{s: SlicedString{`say "hi"`, "x"}, opts: SliceOptions{Quoted: true}, expected: `"say ""hi""",x`},
+		toCheck{s: SlicedString{"a", ""}, opts: SliceOptions{Quoted: true}, expected: `a,`},
+		toCheck{s: SlicedString{"a", ""}, opts: SliceOptions{Quoted: true, Empty: SkipEmpty}, expected: `a,""`},
+		toCheck{s: SlicedString{" a", "b"}, opts: SliceOptions{Delimiter: "; ", Quoted: true, TrimSpace: true}, expected: `" a"; b`},
+	}
+
+	for _, check := range checks {
+		result := check.opts.join(check.s)
+		if result != check.expected {
+			t.Errorf("%#v gave %s, expected %s", check.s, result, check.expected)
+		}
+
+		if !check.opts.Quoted {
+			continue
+		}
+
+		back, err := check.opts.split(result)
+		if err != nil || !reflect.DeepEqual(back, check.s) {
+			t.Errorf("%s was split into %#v, expected %#v: %v", result, back, check.s, err)
+		}
+	}
+}
+
+func TestSlicedStringDelimited(t *testing.T) {
+	opts := Options{Slice: SliceOptions{TrimSpace: true, Empty: SkipEmpty, Quoted: true, Split: true}}
+
+	var s SlicedString
+	err := s.UnmarshalTextWith([]byte(`a, "b, c",,d`), opts)
+	if err != nil || !reflect.DeepEqual(s, SlicedString{"a", "b, c", "d"}) {
+		t.Errorf("s %#v is not a, \"b, c\", d: %v", s, err)
+	}
+
+	err = s.UnmarshalTextWith([]byte(`a,"b`), opts)
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Reason != ReasonSyntax {
+		t.Errorf("Expected a ConversionError with '%s', got '%v'", ReasonSyntax, err)
+	}
+
+	err = s.ScanWith("x; y", Options{Slice: SliceOptions{Delimiter: ";", TrimSpace: true, Split: true}})
+	if err != nil || !reflect.DeepEqual(s, SlicedString{"x", "y"}) {
+		t.Errorf("s %#v is not x, y: %v", s, err)
+	}
+
+	err = s.ScanWith([]byte("{x,y}"), opts)
+	if err != nil || !reflect.DeepEqual(s, SlicedString{"x", "y"}) {
+		t.Errorf("s %#v is not x, y: %v", s, err)
+	}
+
+	err = s.Scan("x,y")
+	if err != nil || !reflect.DeepEqual(s, SlicedString{"x,y"}) {
+		t.Errorf("s %#v is not a single x,y: %v", s, err)
+	}
+
+	DefaultOptions.Slice = opts.Slice
+	defer func() {
+		DefaultOptions.Slice = SliceOptions{}
+	}()
+
+	text, err := SlicedString{"a", "b, c"}.MarshalText()
+	if err != nil || string(text) != `a,"b, c"` {
+		t.Errorf("text %s is not a,\"b, c\": %v", text, err)
+	}
+
+	err = s.UnmarshalText(text)
+	if err != nil || !reflect.DeepEqual(s, SlicedString{"a", "b, c"}) {
+		t.Errorf("s %#v is not a, \"b, c\": %v", s, err)
+	}
+}
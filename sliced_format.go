@@ -0,0 +1,57 @@
+package extratypes
+
+import "encoding/json"
+
+// SlicedFormat is the way that a SlicedString is written on JSON, Text
+// and SQL.
+type SlicedFormat int
+
+// The available formats of a SlicedString
+const (
+	// SlicedArray is a JSON array, such as ["a","b"]
+	SlicedArray SlicedFormat = iota
+	// SlicedSingle is a JSON string when there is a single element, such
+	// as "a", and a JSON array otherwise
+	SlicedSingle
+	// SlicedJoined is the elements joined by the delimiter of
+	// SliceOptions, such as a,b
+	SlicedJoined
+	// SlicedPostgres is a PostgreSQL array literal, such as {a,"b c"}
+	SlicedPostgres
+)
+
+var (
+	// SlicedJSONFormat is the format of SlicedString on JSON. SlicedJoined
+	// and SlicedPostgres are written as a JSON string.
+	SlicedJSONFormat = SlicedArray
+	// SlicedTextFormat is the format of SlicedString on Text, and the
+	// format that the text is read in.
+	SlicedTextFormat = SlicedJoined
+	// SlicedSQLFormat is the format of SlicedString on SQL, an array
+	// literal by default, so it can be stored in a PostgreSQL text[]
+	// column.
+	SlicedSQLFormat = SlicedPostgres
+)
+
+// isText reports whether f is written as a plain text, that is a JSON
+// string on JSON
+func (f SlicedFormat) isText() bool {
+	return f == SlicedJoined || f == SlicedPostgres
+}
+
+// format writes s in f, by the rules of opts
+func (s SlicedString) format(f SlicedFormat, opts Options) ([]byte, error) {
+	switch f {
+	case SlicedJoined:
+		return []byte(opts.Slice.join(s)), nil
+	case SlicedPostgres:
+		return []byte(formatPostgresArray(s)), nil
+	case SlicedSingle:
+		if len(s) == 1 {
+			return json.Marshal(s[0])
+		}
+	}
+
+	// a nil slice is written as an empty array and not as null
+	return json.Marshal(append([]string{}, s...))
+}
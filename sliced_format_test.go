@@ -0,0 +1,227 @@
+package extratypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSlicedFormatMarshal(t *testing.T) {
+	type toCheck = struct {
+		f        SlicedFormat
+		s        SlicedString
+		expected []byte
+	}
+
+	checks := []toCheck{
+		toCheck{f: SlicedArray, s: SlicedString{"a", "b c"}, expected: []byte(`["a","b c"]`)},
+		toCheck{f: SlicedArray, s: SlicedString{"a"}, expected: []byte(`["a"]`)},
+		toCheck{f: SlicedArray, s: SlicedString{}, expected: []byte(`[]`)},
+		toCheck{f: SlicedSingle, s: SlicedString{"a"}, expected: []byte(`"a"`)},
+		toCheck{f: SlicedSingle, s: SlicedString{"a", "b"}, expected: []byte(`["a","b"]`)},
+		toCheck{f: SlicedJoined, s: SlicedString{"a", "b c"}, expected: []byte(`a,b c`)},
+		toCheck{f: SlicedJoined, s: SlicedString{"a"}, expected: []byte(`a`)},
+		toCheck{f: SlicedPostgres, s: SlicedString{"a", "b c"}, expected: []byte(`{a,"b c"}`)},
+		toCheck{f: SlicedPostgres, s: SlicedString{}, expected: []byte(`{}`)},
+	}
+
+	for _, check := range checks {
+		b, err := check.s.format(check.f, DefaultOptions)
+		if err != nil || !bytes.Equal(b, check.expected) {
+			t.Errorf("%d: %#v gave %s, expected %s: %v", check.f, check.s, b, check.expected, err)
+		}
+	}
+
+	b, err := SlicedString{"a", "b"}.format(SlicedJoined, Options{Slice: SliceOptions{Delimiter: "; "}})
+	if err != nil || !bytes.Equal(b, []byte(`a; b`)) {
+		t.Errorf("b %s is not 'a; b': %v", b, err)
+	}
+}
+
+func TestSlicedStringMarshal(t *testing.T) {
+	s := SlicedString{"a", "b"}
+
+	b, err := json.Marshal(s)
+	if err != nil || !bytes.Equal(b, []byte(`["a","b"]`)) {
+		t.Errorf("json %s is not [\"a\",\"b\"]: %v", b, err)
+	}
+
+	b, err = s.MarshalText()
+	if err != nil || !bytes.Equal(b, []byte(`a,b`)) {
+		t.Errorf("text %s is not a,b: %v", b, err)
+	}
+
+	v, err := s.Value()
+	if err != nil || v != `{a,b}` {
+		t.Errorf("value %v is not {a,b}: %v", v, err)
+	}
+
+	var nilSlice SlicedString
+	b, err = json.Marshal(nilSlice)
+	if err != nil || !bytes.Equal(b, []byte(`null`)) {
+		t.Errorf("json %s is not null: %v", b, err)
+	}
+
+	b, err = nilSlice.MarshalText()
+	if err != nil || len(b) != 0 {
+		t.Errorf("text %s is not empty: %v", b, err)
+	}
+
+	v, err = nilSlice.Value()
+	if err != nil || v != nil {
+		t.Errorf("value %v is not nil: %v", v, err)
+	}
+}
+
+func TestSlicedStringGlobal(t *testing.T) {
+	SlicedJSONFormat = SlicedJoined
+	SlicedTextFormat = SlicedSingle
+	SlicedSQLFormat = SlicedJoined
+	defer func() {
+		SlicedJSONFormat = SlicedArray
+		SlicedTextFormat = SlicedJoined
+		SlicedSQLFormat = SlicedPostgres
+	}()
+
+	s := SlicedString{"a", "b"}
+	b, err := json.Marshal(s)
+	if err != nil || !bytes.Equal(b, []byte(`"a,b"`)) {
+		t.Errorf("json %s is not \"a,b\": %v", b, err)
+	}
+
+	b, err = SlicedString{"a"}.MarshalText()
+	if err != nil || !bytes.Equal(b, []byte(`"a"`)) {
+		t.Errorf("text %s is not \"a\": %v", b, err)
+	}
+
+	var result SlicedString
+	err = result.UnmarshalText(b)
+	if err != nil || !reflect.DeepEqual(result, SlicedString{"a"}) {
+		t.Errorf("result %#v is not a: %v", result, err)
+	}
+
+	v, err := s.Value()
+	if err != nil || v != "a,b" {
+		t.Errorf("value %v is not a,b: %v", v, err)
+	}
+}
+
+func TestSlicedStringUnmarshalText(t *testing.T) {
+	type toCheck = struct {
+		text     []byte
+		opts     Options
+		expected SlicedString
+	}
+
+	checks := []toCheck{
+		toCheck{text: []byte(`a,b,c`), expected: SlicedString{"a", "b", "c"}},
+		toCheck{text: []byte(`a`), expected: SlicedString{"a"}},
+		toCheck{text: []byte(`a,,b`), expected: SlicedString{"a", "", "b"}},
+		toCheck{text: []byte(``), expected: nil},
+		toCheck{text: []byte(`a|b`), opts: Options{Slice: SliceOptions{Delimiter: "|"}}, expected: SlicedString{"a", "b"}},
+	}
+
+	for _, check := range checks {
+		result := SlicedString{"old"}
+		err := result.UnmarshalTextWith(check.text, check.opts)
+		if err != nil || !reflect.DeepEqual(result, check.expected) {
+			t.Errorf("%q gave %#v, expected %#v: %v", check.text, result, check.expected, err)
+		}
+	}
+}
+
+func TestSlicedStringNil(t *testing.T) {
+	s := SlicedString{"a"}
+	err := s.Scan(nil)
+	if err != nil || s != nil {
+		t.Errorf("s %#v is not nil: %v", s, err)
+	}
+
+	s = SlicedString{"a"}
+	err = json.Unmarshal([]byte(`null`), &s)
+	if err != nil || s != nil {
+		t.Errorf("s %#v is not nil: %v", s, err)
+	}
+
+	err = s.Scan([]byte("abc"))
+	if err != nil || !reflect.DeepEqual(s, SlicedString{"abc"}) {
+		t.Errorf("s %#v is not abc: %v", s, err)
+	}
+}
+
+func TestSlicedStringSQLFormats(t *testing.T) {
+	defer func() {
+		SlicedSQLFormat = SlicedPostgres
+	}()
+
+	values := []SlicedString{
+		SlicedString{"a", "b c"},
+		SlicedString{"a"},
+		SlicedString{`say "hi"`, "{x}", "[y]"},
+		SlicedString{},
+	}
+
+	for _, f := range []SlicedFormat{SlicedArray, SlicedSingle, SlicedJoined, SlicedPostgres} {
+		SlicedSQLFormat = f
+		for _, s := range values {
+			if f == SlicedJoined && len(s) == 0 {
+				// an empty text is a nil slice
+				continue
+			}
+
+			v, err := s.Value()
+			if err != nil {
+				t.Errorf("%d: %#v: unexpected error: %s", f, s, err)
+				continue
+			}
+
+			var result SlicedString
+			err = result.Scan(v)
+			if err != nil || !reflect.DeepEqual(result, s) {
+				t.Errorf("%d: %v gave %#v, expected %#v: %v", f, v, result, s, err)
+			}
+		}
+
+		var result SlicedString
+		err := result.Scan("plain text")
+		if err != nil || !reflect.DeepEqual(result, SlicedString{"plain text"}) {
+			t.Errorf("%d: plain text gave %#v: %v", f, result, err)
+		}
+	}
+}
+
+func TestSlicedStringJSONFormats(t *testing.T) {
+	defer func() {
+		SlicedJSONFormat = SlicedArray
+	}()
+
+	values := []SlicedString{
+		SlicedString{"a", "b c"},
+		SlicedString{"a"},
+		SlicedString{`say "hi"`, "{x}", "[y]"},
+		SlicedString{},
+	}
+
+	for _, f := range []SlicedFormat{SlicedArray, SlicedSingle, SlicedJoined, SlicedPostgres} {
+		SlicedJSONFormat = f
+		for _, s := range values {
+			if f == SlicedJoined && len(s) == 0 {
+				// an empty text is a nil slice
+				continue
+			}
+
+			data, err := json.Marshal(s)
+			if err != nil {
+				t.Errorf("%d: %#v: unexpected error: %s", f, s, err)
+				continue
+			}
+
+			var result SlicedString
+			err = json.Unmarshal(data, &result)
+			if err != nil || !reflect.DeepEqual(result, s) {
+				t.Errorf("%d: %s gave %#v, expected %#v: %v", f, data, result, s, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package extratypes
+
+// NullPolicy tells what to do with a null element of an array, such as
+// null on JSON or NULL in a PostgreSQL array
+type NullPolicy int
+
+// The available policies of null elements
+const (
+	// KeepNull keeps a null element as an empty string
+	KeepNull NullPolicy = iota
+	// SkipNull drops a null element
+	SkipNull
+	// RejectNull returns an error with ReasonNilNotAllowed on a null
+	// element
+	RejectNull
+)
+
+// SliceOptions holds the rules of reading the elements of a SlicedString,
+// and of reading and writing them as a single text, such as "a,b,c" or
+// "a; b; c".
+type SliceOptions struct {
+	// Delimiter separates the elements of SlicedJoined. When empty, "," is
+	// used.
+	Delimiter string
+
+	// TrimSpace removes the spaces around each element that is not
+	// quoted.
+	TrimSpace bool
+
+	// Empty is the policy of an element that is empty, after TrimSpace.
+	// A quoted empty element ("") is always kept.
+	Empty EmptyPolicy
+
+	// Quoted reads and writes elements that are quoted as in CSV (RFC
+	// 4180), so "a,b" is a single element and a quote inside it is
+	// written twice. An element is quoted when it is written only if it
+	// needs to be.
+	Quoted bool
+
+	// Split makes Scan split a string by these rules, as a text in
+	// SlicedJoined, instead of keeping it as a single element. An array
+	// literal is still read as an array.
+	Split bool
+
+	// Coerce turns an element that is a number or a bool into a string,
+	// such as 1 into "1", instead of returning an error. A JSON number is
+	// kept as it was written.
+	Coerce bool
+
+	// Flatten takes the elements of a nested array, so ["a", ["b", "c"]]
+	// is three elements, instead of returning an error.
+	Flatten bool
+
+	// Null is the policy of a null element of an array
+	Null NullPolicy
+
+	// Lenient converts the elements of a Sliced by the rules of Options,
+	// so an element that cannot be converted becomes the zero value of
+	// its type, instead of returning an *ElementError.
+	Lenient bool
+}
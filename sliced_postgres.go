@@ -0,0 +1,188 @@
+package extratypes
+
+import "strings"
+
+// isPostgresArray reports whether s looks like a PostgreSQL array literal,
+// such as {a,b} or [1:2]={a,b}
+func isPostgresArray(s string) bool {
+	return strings.HasSuffix(s, "}") &&
+		(strings.HasPrefix(s, "{") || strings.HasPrefix(s, "[") && strings.Contains(s, "={"))
+}
+
+// parsePostgresArray parses a one dimensional PostgreSQL array literal,
+// such as {a,"b c",NULL}. A NULL element is returned as nil, and the rest
+// as strings.
+func parsePostgresArray(s string) ([]interface{}, error) {
+	if strings.HasPrefix(s, "[") {
+		// the bounds of the dimension, such as [1:2]={a,b}
+		i := strings.Index(s, "={")
+		if i < 0 {
+			return nil, ErrSyntax
+		}
+		s = s[i+1:]
+	}
+
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, ErrSyntax
+	}
+
+	s = s[1 : len(s)-1]
+	result := []interface{}{}
+	if strings.TrimSpace(s) == "" {
+		return result, nil
+	}
+
+	for i := 0; ; {
+		for i < len(s) && isPostgresSpace(s[i]) {
+			i++
+		}
+
+		if i < len(s) && s[i] == '{' {
+			return nil, ErrUnsupported
+		}
+
+		elem, quoted, next, err := parsePostgresElement(s, i)
+		if err != nil {
+			return nil, err
+		}
+
+		if !quoted && strings.EqualFold(elem, "NULL") {
+			result = append(result, nil)
+		} else {
+			result = append(result, elem)
+		}
+
+		i = next
+		for i < len(s) && isPostgresSpace(s[i]) {
+			i++
+		}
+
+		if i == len(s) {
+			return result, nil
+		}
+
+		if s[i] != ',' {
+			return nil, ErrSyntax
+		}
+		i++
+	}
+}
+
+// parsePostgresElement parses the element of an array literal that starts
+// at s[i], and returns it, whether it was quoted, and the index after it.
+func parsePostgresElement(s string, i int) (string, bool, int, error) {
+	var b strings.Builder
+
+	if i < len(s) && s[i] == '"' {
+		for i++; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				i++
+				if i == len(s) {
+					return "", false, i, ErrSyntax
+				}
+				b.WriteByte(s[i])
+			case '"':
+				return b.String(), true, i + 1, nil
+			default:
+				b.WriteByte(s[i])
+			}
+		}
+
+		return "", false, i, ErrSyntax
+	}
+
+	// spaces around an unquoted element are ignored, but an escaped space
+	// is kept
+	escaped := false
+	end := 0
+	for ; i < len(s) && s[i] != ','; i++ {
+		switch s[i] {
+		case '"', '{', '}':
+			return "", false, i, ErrSyntax
+		case '\\':
+			i++
+			if i == len(s) {
+				return "", false, i, ErrSyntax
+			}
+			escaped = true
+			b.WriteByte(s[i])
+			end = b.Len()
+		default:
+			b.WriteByte(s[i])
+			if !isPostgresSpace(s[i]) {
+				end = b.Len()
+			}
+		}
+	}
+
+	elem := b.String()[:end]
+	if elem == "" {
+		return "", false, i, ErrSyntax
+	}
+
+	// an escaped NULL is the string and not a NULL element
+	return elem, escaped, i, nil
+}
+
+// isPostgresSpace reports whether c is a space that is ignored between the
+// elements of an array literal
+func isPostgresSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+
+	return false
+}
+
+// formatPostgresArray writes s as a PostgreSQL array literal. An element is
+// quoted when it is empty, is NULL, or has a character that is special in
+// the literal.
+func formatPostgresArray(s SlicedString) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, elem := range s {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		if !needsPostgresQuote(elem) {
+			b.WriteString(elem)
+			continue
+		}
+
+		b.WriteByte('"')
+		for j := 0; j < len(elem); j++ {
+			if elem[j] == '"' || elem[j] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(elem[j])
+		}
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+// needsPostgresQuote reports whether elem must be quoted in an array
+// literal
+func needsPostgresQuote(elem string) bool {
+	if elem == "" || strings.EqualFold(elem, "NULL") {
+		return true
+	}
+
+	for i := 0; i < len(elem); i++ {
+		switch elem[i] {
+		case '{', '}', ',', '"', '\\':
+			return true
+		}
+
+		if isPostgresSpace(elem[i]) {
+			return true
+		}
+	}
+
+	return false
+}
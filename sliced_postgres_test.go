@@ -0,0 +1,179 @@
+package extratypes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestParsePostgresArray(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		expected []interface{}
+		err      error
+	}
+
+	checks := []toCheck{
+		toCheck{s: `{}`, expected: []interface{}{}},
+		toCheck{s: `{a}`, expected: []interface{}{"a"}},
+		toCheck{s: `{a,"b c",NULL}`, expected: []interface{}{"a", "b c", nil}},
+		toCheck{s: `{ a , b c }`, expected: []interface{}{"a", "b c"}},
+		toCheck{s: `{"NULL",null,N\ULL}`, expected: []interface{}{"NULL", nil, "NULL"}},
+		toCheck{s: `{"a \"quoted\" \\ word",""}`, expected: []interface{}{`a "quoted" \ word`, ""}},
+		toCheck{s: `{a\,b,c\ }`, expected: []interface{}{"a,b", "c "}},
+		toCheck{s: `{"{x}",",",שלום}`, expected: []interface{}{"{x}", ",", "שלום"}},
+		toCheck{s: `[1:2]={a,b}`, expected: []interface{}{"a", "b"}},
+		toCheck{s: `{a,,b}`, err: ErrSyntax},
+		toCheck{s: `{a,}`, err: ErrSyntax},
+		toCheck{s: `{"a}`, err: ErrSyntax},
+		toCheck{s: `{"a" b}`, err: ErrSyntax},
+		toCheck{s: `{a"b}`, err: ErrSyntax},
+		toCheck{s: `{{a,b},{c,d}}`, err: ErrUnsupported},
+		toCheck{s: `{"a":1}`, err: ErrSyntax},
+	}
+
+	for _, check := range checks {
+		result, err := parsePostgresArray(check.s)
+		if check.err != nil {
+			if !errors.Is(err, check.err) {
+				t.Errorf("%s: expected '%s', got '%v'", check.s, check.err, err)
+			}
+			continue
+		}
+
+		if err != nil || !reflect.DeepEqual(result, check.expected) {
+			t.Errorf("%s gave %#v, expected %#v: %v", check.s, result, check.expected, err)
+		}
+	}
+}
+
+func TestFormatPostgresArray(t *testing.T) {
+	type toCheck = struct {
+		s        SlicedString
+		expected string
+	}
+
+	checks := []toCheck{
+		toCheck{s: SlicedString{}, expected: `{}`},
+		toCheck{s: SlicedString{"a", "b"}, expected: `{a,b}`},
+		toCheck{s: SlicedString{"", "NULL", "null"}, expected: `{"","NULL","null"}`},
+		toCheck{s: SlicedString{"b c", "a,b", "{x}"}, expected: `{"b c","a,b","{x}"}`},
+		toCheck{s: SlicedString{`say "hi"`, `c:\dir`}, expected: `{"say \"hi\"","c:\\dir"}`},
+	}
+
+	for _, check := range checks {
+		result := formatPostgresArray(check.s)
+		if result != check.expected {
+			t.Errorf("%#v gave %s, expected %s", check.s, result, check.expected)
+		}
+
+		back, err := parsePostgresArray(result)
+		if err != nil || len(back) != len(check.s) {
+			t.Errorf("%s was parsed into %#v: %v", result, back, err)
+			continue
+		}
+
+		for i := range back {
+			if back[i] != check.s[i] {
+				t.Errorf("%s: element %d is %#v, expected %q", result, i, back[i], check.s[i])
+			}
+		}
+	}
+}
+
+func TestSlicedStringPostgres(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"tags"}).
+		AddRow([]byte(`{a,"b c",NULL}`)).
+		AddRow(`{"a":1}`).
+		AddRow(`{}`).
+		AddRow(nil)
+	mock.ExpectQuery("^SELECT (.+) FROM tags").WillReturnRows(rows)
+
+	result, err := db.Query("SELECT tags FROM tags")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+	defer result.Close()
+
+	expected := []SlicedString{
+		SlicedString{"a", "b c", ""},
+		SlicedString{`{"a":1}`},
+		SlicedString{},
+		nil,
+	}
+
+	i := 0
+	for result.Next() {
+		var tags SlicedString
+		err = result.Scan(&tags)
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(tags, expected[i]) {
+			t.Errorf("%d: tags %#v is not %#v", i, tags, expected[i])
+		}
+		i++
+	}
+
+	mock.ExpectExec("^INSERT INTO tags").
+		WithArgs(`{a,"b c"}`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT INTO tags (tags) VALUES (?)", SlicedString{"a", "b c"})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	err = mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Unfulfilled expectations: %s", err)
+	}
+}
+
+func TestSlicedStringPostgresMultiDimension(t *testing.T) {
+	var s SlicedString
+	err := s.Scan(`{{a,b},{c,d}}`)
+	if !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Expected '%s', got '%v'", ErrUnsupported, err)
+	}
+
+	var i SlicedInt
+	err = i.Scan(`{{1,2},{3,4}}`)
+	if !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Expected '%s', got '%v'", ErrUnsupported, err)
+	}
+
+	err = s.Scan(`{"a":1}`)
+	if err != nil || !reflect.DeepEqual(s, SlicedString{`{"a":1}`}) {
+		t.Errorf("s %#v is not a single {\"a\":1}: %v", s, err)
+	}
+}
+
+func TestSlicedStringPostgresText(t *testing.T) {
+	SlicedTextFormat = SlicedPostgres
+	defer func() {
+		SlicedTextFormat = SlicedJoined
+	}()
+
+	var s SlicedString
+	err := s.UnmarshalText([]byte(`{a,"b,c"}`))
+	if err != nil || !reflect.DeepEqual(s, SlicedString{"a", "b,c"}) {
+		t.Errorf("s %#v is not {a,\"b,c\"}: %v", s, err)
+	}
+
+	err = s.UnmarshalText([]byte(`{a,"b`))
+	if !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+	}
+}
@@ -1,8 +1,9 @@
 package extratypes
 
 import (
+	"database/sql/driver"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"reflect"
 )
 
@@ -10,87 +11,332 @@ import (
 // based on serialization JSON/Text/DB.
 type SlicedString []string
 
+var (
+	slicedStringType = reflect.TypeOf(SlicedString{})
+	stringType       = reflect.TypeOf("")
+)
+
 // UnmarshalJSON for contacts
 func (s *SlicedString) UnmarshalJSON(data []byte) error {
-	var str interface{}
-	err := json.Unmarshal(data, &str)
+	return s.UnmarshalJSONWith(data, DefaultOptions)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but converts data by the rules
+// of opts
+func (s *SlicedString) UnmarshalJSONWith(data []byte, opts Options) error {
+	str, err := decodeSlicedJSON(data, opts)
 	if err != nil {
-		return err
+		return newConversionError(data, slicedStringType, ReasonSyntax, err)
 	}
 
-	items := reflect.ValueOf(str)
-	kind := items.Kind()
-	var result SlicedString
-
-	switch kind {
-	case reflect.String:
-		result = append(result, items.String())
-
-	case reflect.Slice:
-		for i := 0; i < items.Len(); i++ {
-			item := items.Index(i)
-			switch item.Kind() {
-			case reflect.String:
-				result = append(result, item.String())
-			case reflect.Interface:
-				sliceItem := reflect.ValueOf(item.Interface())
-				sliceKind := sliceItem.Kind()
-				switch sliceKind {
-				case reflect.String:
-					result = append(result, sliceItem.String())
-				default:
-					return fmt.Errorf("unsupported type '%s' in slice", sliceKind)
-				}
-			}
+	// a text format is written as a JSON string, that is read back in
+	// the same format
+	if text, ok := str.(string); ok && SlicedJSONFormat.isText() {
+		str, err = fromSlicedText(text, SlicedJSONFormat, opts)
+		if err != nil {
+			return err
 		}
-	default:
-		return fmt.Errorf("unsupported type '%s'", kind)
 	}
 
-	*s = make(SlicedString, 0, len(result))
+	if str == nil {
+		*s = nil
+		return nil
+	}
+
+	result, err := sliceOf(str, opts.Slice)
+	if err != nil {
+		return err
+	}
+
 	*s = result
 	return nil
 }
 
-// Scan implements the Scanner interface.
+// Scan implements the Scanner interface. A string is read in
+// SlicedSQLFormat, and one that is not in the format is a single element.
 func (s *SlicedString) Scan(value interface{}) error {
+	return s.ScanWith(value, DefaultOptions)
+}
+
+// ScanWith is like Scan, but converts value by the rules of opts
+func (s *SlicedString) ScanWith(value interface{}, opts Options) error {
 	if value == nil {
-		s = nil
+		*s = nil
+		return nil
+	}
+
+	// a text column may arrive as []byte, that is a single string and not
+	// a slice of bytes
+	if b, ok := value.([]byte); ok {
+		value = string(b)
+	}
+
+	if str, ok := value.(string); ok {
+		var err error
+		value, err = fromSlicedSQL(str, opts)
+		if err != nil {
+			return err
+		}
+
+		if value == nil {
+			*s = nil
+			return nil
+		}
+	}
+
+	result, err := sliceOf(value, opts.Slice)
+	if err != nil {
+		return err
+	}
+
+	*s = result
+	return nil
+}
+
+// decodeSlicedJSON decodes data into interface{}. A number is kept as it
+// was written, as a json.Number, when opts coerce the elements.
+func decodeSlicedJSON(data []byte, opts Options) (interface{}, error) {
+	if opts.Slice.Coerce {
+		return decodeJSON(data)
+	}
+
+	var v interface{}
+	err := json.Unmarshal(data, &v)
+	return v, err
+}
+
+// fromSlicedSQL returns the elements of str, that was written in
+// SlicedSQLFormat, as a string or a slice for sliceOf. A string that is not
+// in the format is a single element, and nil is a nil slice.
+func fromSlicedSQL(str string, opts Options) (interface{}, error) {
+	// a PostgreSQL array, such as text[], arrives as an array literal
+	// whatever the format is. A string that only looks like one, and is
+	// not a valid literal, is kept as is, but a literal of an array with
+	// more than one dimension is an error, as it is in Sliced.
+	if isPostgresArray(str) {
+		elems, err := parsePostgresArray(str)
+		switch {
+		case err == nil:
+			return elems, nil
+		case errors.Is(err, ErrUnsupported):
+			return nil, asConversionError(str, slicedStringType, ReasonUnsupported, err)
+		}
+	}
+
+	switch {
+	case SlicedSQLFormat == SlicedJoined || opts.Slice.Split:
+		return fromSlicedText(str, SlicedJoined, opts)
+
+	case SlicedSQLFormat == SlicedArray || SlicedSQLFormat == SlicedSingle:
+		v, err := decodeSlicedJSON([]byte(str), opts)
+		if err != nil {
+			break
+		}
+
+		switch v.(type) {
+		case string, []interface{}:
+			return v, nil
+		}
+	}
+
+	return str, nil
+}
+
+// fromSlicedText returns the elements of str, that was written in the text
+// format f, as a string or a slice for sliceOf. A string that only looks
+// like an array literal is a single element, and an empty joined text is
+// a nil slice.
+func fromSlicedText(str string, f SlicedFormat, opts Options) (interface{}, error) {
+	switch f {
+	case SlicedJoined:
+		if str == "" {
+			return nil, nil
+		}
+
+		elems, err := opts.Slice.split(str)
+		if err != nil {
+			return nil, asConversionError(str, slicedStringType, ReasonSyntax, err)
+		}
+		return []string(elems), nil
+
+	case SlicedPostgres:
+		elems, err := parsePostgresArray(str)
+		switch {
+		case err == nil:
+			return elems, nil
+		case errors.Is(err, ErrUnsupported):
+			return nil, asConversionError(str, slicedStringType, ReasonUnsupported, err)
+		}
+	}
+
+	return str, nil
+}
+
+// Value implements the driver Valuer interface, in SlicedSQLFormat.
+// A nil slice is NULL.
+func (s SlicedString) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	b, err := s.format(SlicedSQLFormat, DefaultOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// MarshalJSON implement the Marshaler interface, in SlicedJSONFormat.
+// A nil slice is null.
+func (s SlicedString) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return json.Marshal(nil)
+	}
+
+	if SlicedJSONFormat.isText() {
+		b, err := s.format(SlicedJSONFormat, DefaultOptions)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(b))
+	}
+
+	return s.format(SlicedJSONFormat, DefaultOptions)
+}
+
+// MarshalText implement Text Marshaller interface, in SlicedTextFormat.
+// A nil slice is an empty text.
+func (s SlicedString) MarshalText() ([]byte, error) {
+	if s == nil {
+		return []byte(""), nil
+	}
+
+	return s.format(SlicedTextFormat, DefaultOptions)
+}
+
+// UnmarshalText implement the text un-Marshaller interface, it reads the
+// text in SlicedTextFormat. An empty text is a nil slice.
+func (s *SlicedString) UnmarshalText(data []byte) error {
+	return s.UnmarshalTextWith(data, DefaultOptions)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but converts data by the rules
+// of opts
+func (s *SlicedString) UnmarshalTextWith(data []byte, opts Options) error {
+	if len(data) == 0 {
+		*s = nil
 		return nil
 	}
 
-	items := reflect.ValueOf(value)
-	kind := items.Kind()
-	var result SlicedString
+	switch SlicedTextFormat {
+	case SlicedJoined:
+		result, err := opts.Slice.split(string(data))
+		if err != nil {
+			return asConversionError(string(data), slicedStringType, ReasonSyntax, err)
+		}
+		*s = result
+		return nil
+	case SlicedPostgres:
+		elems, err := parsePostgresArray(string(data))
+		if err != nil {
+			return asConversionError(string(data), slicedStringType, ReasonSyntax, err)
+		}
 
-	switch kind {
+		result, err := sliceOf(elems, opts.Slice)
+		if err != nil {
+			return err
+		}
+		*s = result
+		return nil
+	}
+
+	return s.UnmarshalJSONWith(data, opts)
+}
+
+// sliceOf returns v, that is a string or a slice of strings, as a
+// SlicedString. The elements are converted by the rules of opts.
+func sliceOf(v interface{}, opts SliceOptions) (SlicedString, error) {
+	items := reflect.ValueOf(v)
+
+	switch items.Kind() {
 	case reflect.String:
-		result = append(result, items.String())
-
-	case reflect.Slice:
-		for i := 0; i < items.Len(); i++ {
-			item := items.Index(i)
-			switch item.Kind() {
-			case reflect.String:
-				result = append(result, item.String())
-			case reflect.Interface:
-				sliceItem := reflect.ValueOf(item.Interface())
-				sliceKind := sliceItem.Kind()
-				switch sliceKind {
-				case reflect.String:
-					result = append(result, sliceItem.String())
-				default:
-					return fmt.Errorf("unsupported type '%s' in slice", sliceKind)
+		return SlicedString{items.String()}, nil
+
+	case reflect.Slice, reflect.Array:
+		result := make(SlicedString, 0, items.Len())
+		return opts.appendElements(result, items)
+	}
+
+	if str, ok := opts.coerce(items); ok {
+		return SlicedString{str}, nil
+	}
+
+	return nil, newConversionError(items.Interface(), slicedStringType, ReasonUnsupported, nil)
+}
+
+// appendElements appends the elements of items to result, by the rules of
+// o
+func (o SliceOptions) appendElements(result SlicedString, items reflect.Value) (SlicedString, error) {
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i)
+		if item.Kind() == reflect.Interface || item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				switch o.Null {
+				case KeepNull:
+					result = append(result, "")
+				case RejectNull:
+					return nil, newConversionError(nil, stringType, ReasonNilNotAllowed, nil)
+				}
+				continue
+			}
+			item = item.Elem()
+		}
+
+		if b, ok := item.Interface().([]byte); ok {
+			result = append(result, string(b))
+			continue
+		}
+
+		switch item.Kind() {
+		case reflect.String:
+			result = append(result, item.String())
+			continue
+
+		case reflect.Slice, reflect.Array:
+			if o.Flatten {
+				var err error
+				result, err = o.appendElements(result, item)
+				if err != nil {
+					return nil, err
 				}
+				continue
 			}
 		}
-	default:
-		return fmt.Errorf("unsupported type '%s'", kind)
+
+		str, ok := o.coerce(item)
+		if !ok {
+			return nil, newConversionError(item.Interface(), stringType, ReasonUnsupported, nil)
+		}
+		result = append(result, str)
 	}
 
-	*s = make(SlicedString, 0, len(result))
-	*s = result
-	return nil
+	return result, nil
+}
 
-	return nil
+// coerce returns item as a string when it is a number or a bool and o
+// coerces them
+func (o SliceOptions) coerce(item reflect.Value) (string, bool) {
+	if !o.Coerce {
+		return "", false
+	}
+
+	switch item.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Bool:
+		return asString(item.Interface()), true
+	}
+
+	return "", false
 }
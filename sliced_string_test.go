@@ -3,7 +3,6 @@ package extratypes
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"reflect"
 	"testing"
 )
@@ -45,19 +44,19 @@ func TestSlicedStringJSONMarshal(t *testing.T) {
 			s:        "1",
 			expected: nil,
 			hasError: true,
-			err:      errors.New("unsupported type 'float64'"),
+			err:      newConversionError(float64(1), slicedStringType, ReasonUnsupported, nil),
 		},
 		toCheck{
 			s:        "1.1",
 			expected: nil,
 			hasError: true,
-			err:      errors.New("unsupported type 'float64'"),
+			err:      newConversionError(1.1, slicedStringType, ReasonUnsupported, nil),
 		},
 		toCheck{
 			s:        "true",
 			expected: nil,
 			hasError: true,
-			err:      errors.New("unsupported type 'bool'"),
+			err:      newConversionError(true, slicedStringType, ReasonUnsupported, nil),
 		},
 		toCheck{
 			s:        `["a", "b", "c"]`,
@@ -69,13 +68,13 @@ func TestSlicedStringJSONMarshal(t *testing.T) {
 			s:        `[ 1, "a" ]`,
 			expected: nil,
 			hasError: true,
-			err:      errors.New("unsupported type 'float64' in slice"),
+			err:      newConversionError(float64(1), stringType, ReasonUnsupported, nil),
 		},
 		toCheck{
 			s:        `[ "a", 1 ]`,
 			expected: nil,
 			hasError: true,
-			err:      errors.New("unsupported type 'float64' in slice"),
+			err:      newConversionError(float64(1), stringType, ReasonUnsupported, nil),
 		},
 		toCheck{
 			s:        "'a'",
@@ -216,13 +215,13 @@ func TestSlicedStringScan(t *testing.T) {
 			s:        1,
 			expected: nil,
 			hasError: true,
-			err:      fmt.Errorf("unsupported type '%s'", "int"),
+			err:      newConversionError(1, slicedStringType, ReasonUnsupported, nil),
 		},
 		toCheck{
 			s:        []interface{}{1},
 			expected: nil,
 			hasError: true,
-			err:      fmt.Errorf("unsupported type '%s' in slice", "int"),
+			err:      newConversionError(1, stringType, ReasonUnsupported, nil),
 		},
 	}
 
@@ -288,3 +287,71 @@ func TestSlicedStringScan(t *testing.T) {
 		}
 	})
 }
+
+func TestSlicedStringCoerce(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		opts     SliceOptions
+		expected SlicedString
+		err      error
+	}
+
+	coerce := SliceOptions{Coerce: true}
+	checks := []toCheck{
+		toCheck{s: `["a", 1, true]`, opts: coerce, expected: SlicedString{"a", "1", "true"}},
+		toCheck{s: `[1.50, 12345678901234567890, false]`, opts: coerce, expected: SlicedString{"1.50", "12345678901234567890", "false"}},
+		toCheck{s: `7`, opts: coerce, expected: SlicedString{"7"}},
+		toCheck{s: `[]`, expected: SlicedString{}},
+		toCheck{s: `["a", ["b", ["c"]]]`, opts: SliceOptions{Flatten: true}, expected: SlicedString{"a", "b", "c"}},
+		toCheck{s: `["a", [1]]`, opts: SliceOptions{Flatten: true, Coerce: true}, expected: SlicedString{"a", "1"}},
+		toCheck{s: `["a", ["b"]]`, opts: coerce, err: ErrUnsupported},
+		toCheck{s: `["a", {"b": 1}]`, opts: SliceOptions{Flatten: true, Coerce: true}, err: ErrUnsupported},
+		toCheck{s: `["a", null, "b"]`, expected: SlicedString{"a", "", "b"}},
+		toCheck{s: `["a", null, "b"]`, opts: SliceOptions{Null: SkipNull}, expected: SlicedString{"a", "b"}},
+		toCheck{s: `["a", null, "b"]`, opts: SliceOptions{Null: RejectNull}, err: ErrNilNotAllowed},
+		toCheck{s: `["a", 1]`, err: ErrUnsupported},
+	}
+
+	for _, check := range checks {
+		var rec SlicedString
+		err := rec.UnmarshalJSONWith([]byte(check.s), Options{Slice: check.opts})
+		if check.err != nil {
+			if !errors.Is(err, check.err) {
+				t.Errorf("%s: expected '%s', got '%v'", check.s, check.err, err)
+			}
+			continue
+		}
+
+		if err != nil || !reflect.DeepEqual(rec, check.expected) {
+			t.Errorf("%s gave %#v, expected %#v: %v", check.s, rec, check.expected, err)
+		}
+	}
+
+	t.Run("scan", func(t2 *testing.T) {
+		var rec SlicedString
+		err := rec.ScanWith([]interface{}{"a", int64(2), 2.5, []byte("b"), nil}, Options{Slice: coerce})
+		if err != nil || !reflect.DeepEqual(rec, SlicedString{"a", "2", "2.5", "b", ""}) {
+			t2.Errorf("rec %#v is not a, 2, 2.5, b: %v", rec, err)
+		}
+
+		err = rec.Scan([]int{1, 2})
+		if !errors.Is(err, ErrUnsupported) {
+			t2.Errorf("Expected '%s', got '%v'", ErrUnsupported, err)
+		}
+
+		err = rec.ScanWith([]int{1, 2}, Options{Slice: coerce})
+		if err != nil || !reflect.DeepEqual(rec, SlicedString{"1", "2"}) {
+			t2.Errorf("rec %#v is not 1, 2: %v", rec, err)
+		}
+
+		err = rec.ScanWith(`{a,NULL}`, Options{Slice: SliceOptions{Null: SkipNull}})
+		if err != nil || !reflect.DeepEqual(rec, SlicedString{"a"}) {
+			t2.Errorf("rec %#v is not a: %v", rec, err)
+		}
+
+		err = rec.ScanWith(`{a,NULL}`, Options{Slice: SliceOptions{Null: RejectNull}})
+		if !errors.Is(err, ErrNilNotAllowed) {
+			t2.Errorf("Expected '%s', got '%v'", ErrNilNotAllowed, err)
+		}
+	})
+}
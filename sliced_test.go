@@ -0,0 +1,340 @@
+package extratypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestSlicedUnmarshalJSON(t *testing.T) {
+	type toCheck = struct {
+		s        string
+		expected SlicedInt
+		index    int
+		err      error
+	}
+
+	checks := []toCheck{
+		toCheck{s: `5`, expected: SlicedInt{5}},
+		toCheck{s: `"5"`, expected: SlicedInt{5}},
+		toCheck{s: `[5, "6", 7.0]`, expected: SlicedInt{5, 6, 7}},
+		toCheck{s: `[]`, expected: SlicedInt{}},
+		toCheck{s: `null`, expected: nil},
+		toCheck{s: `[1, null, 3]`, expected: SlicedInt{1, 0, 3}},
+		toCheck{s: `[1, [2]]`, index: 1, err: ErrUnsupported},
+		toCheck{s: `[1, 2, [3, 4]]`, index: 2, err: ErrUnsupported},
+	}
+
+	for _, check := range checks {
+		var rec SlicedInt
+		err := json.Unmarshal([]byte(check.s), &rec)
+		if check.err != nil {
+			if !errors.Is(err, check.err) {
+				t.Errorf("%s: expected '%s', got '%v'", check.s, check.err, err)
+			}
+
+			var elemErr *ElementError
+			if check.index >= 0 && (!errors.As(err, &elemErr) || elemErr.Index != check.index) {
+				t.Errorf("%s: expected element %d, got '%v'", check.s, check.index, err)
+			}
+			continue
+		}
+
+		if err != nil || !reflect.DeepEqual(rec, check.expected) {
+			t.Errorf("%s gave %#v, expected %#v: %v", check.s, rec, check.expected, err)
+		}
+	}
+}
+
+func TestSlicedTypes(t *testing.T) {
+	var b SlicedBool
+	err := json.Unmarshal([]byte(`["yes", 0, true, "F"]`), &b)
+	if err != nil || !reflect.DeepEqual(b, SlicedBool{true, false, true, false}) {
+		t.Errorf("b %#v is not yes, 0, true, F: %v", b, err)
+	}
+
+	var d SlicedDuration
+	err = json.Unmarshal([]byte(`["1h", "PT30M", "2 days", 1500]`), &d)
+	expected := SlicedDuration{time.Hour, 30 * time.Minute, 48 * time.Hour, 1500}
+	if err != nil || !reflect.DeepEqual(d, expected) {
+		t.Errorf("d %#v is not %#v: %v", d, expected, err)
+	}
+
+	err = json.Unmarshal([]byte(`["1h", "soon"]`), &d)
+	var elemErr *ElementError
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 || !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s' on element 1, got '%v'", ErrSyntax, err)
+	}
+
+	var i8 Sliced[int8]
+	err = i8.UnmarshalJSONWith([]byte(`[1, 300]`), Options{Strict: true})
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 || !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected '%s' on element 1, got '%v'", ErrOverflow, err)
+	}
+
+	var i SlicedInt
+	err = i.UnmarshalJSONWith([]byte(`[1, "x"]`), Options{Strict: true})
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 || !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s' on element 1, got '%v'", ErrSyntax, err)
+	}
+
+	err = i.UnmarshalJSONWith([]byte(`[1, "x"]`), Options{Slice: SliceOptions{Lenient: true}})
+	if err != nil || !reflect.DeepEqual(i, SlicedInt{1, 0}) {
+		t.Errorf("i %#v is not 1, 0: %v", i, err)
+	}
+
+	err = i.UnmarshalJSONWith([]byte(`[1, [2, [3]], null]`), Options{Slice: SliceOptions{Flatten: true, Null: SkipNull}})
+	if err != nil || !reflect.DeepEqual(i, SlicedInt{1, 2, 3}) {
+		t.Errorf("i %#v is not 1, 2, 3: %v", i, err)
+	}
+
+	err = i.UnmarshalJSONWith([]byte(`[1, null]`), Options{Slice: SliceOptions{Null: RejectNull}})
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 || !errors.Is(err, ErrNilNotAllowed) {
+		t.Errorf("Expected '%s' on element 1, got '%v'", ErrNilNotAllowed, err)
+	}
+}
+
+func TestSlicedMarshal(t *testing.T) {
+	i := SlicedInt{1, 2}
+
+	b, err := json.Marshal(i)
+	if err != nil || !bytes.Equal(b, []byte(`[1,2]`)) {
+		t.Errorf("json %s is not [1,2]: %v", b, err)
+	}
+
+	b, err = i.MarshalText()
+	if err != nil || !bytes.Equal(b, []byte(`1,2`)) {
+		t.Errorf("text %s is not 1,2: %v", b, err)
+	}
+
+	v, err := SlicedBool{true, false}.Value()
+	if err != nil || v != `{true,false}` {
+		t.Errorf("value %v is not {true,false}: %v", v, err)
+	}
+
+	var nilSlice SlicedInt
+	b, err = json.Marshal(nilSlice)
+	if err != nil || !bytes.Equal(b, []byte(`null`)) {
+		t.Errorf("json %s is not null: %v", b, err)
+	}
+
+	v, err = nilSlice.Value()
+	if err != nil || v != nil {
+		t.Errorf("value %v is not nil: %v", v, err)
+	}
+
+	var result SlicedInt
+	err = result.UnmarshalText([]byte(`1,2`))
+	if err != nil || !reflect.DeepEqual(result, i) {
+		t.Errorf("result %#v is not %#v: %v", result, i, err)
+	}
+
+	SlicedJSONFormat = SlicedSingle
+	defer func() {
+		SlicedJSONFormat = SlicedArray
+	}()
+
+	b, err = json.Marshal(SlicedDuration{time.Second})
+	if err != nil || !bytes.Equal(b, []byte(`1000000000`)) {
+		t.Errorf("json %s is not 1000000000: %v", b, err)
+	}
+}
+
+func TestSlicedScan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"ids"}).
+		AddRow([]byte(`{1,2,NULL}`)).
+		AddRow(int64(5)).
+		AddRow(`{}`).
+		AddRow(nil)
+	mock.ExpectQuery("^SELECT (.+) FROM items").WillReturnRows(rows)
+
+	result, err := db.Query("SELECT ids FROM items")
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+	defer result.Close()
+
+	expected := []SlicedInt{
+		SlicedInt{1, 2, 0},
+		SlicedInt{5},
+		SlicedInt{},
+		nil,
+	}
+
+	i := 0
+	for result.Next() {
+		var ids SlicedInt
+		err = result.Scan(&ids)
+		if err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(ids, expected[i]) {
+			t.Errorf("%d: ids %#v is not %#v", i, ids, expected[i])
+		}
+		i++
+	}
+
+	mock.ExpectExec("^INSERT INTO items").
+		WithArgs(`{1,2}`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT INTO items (ids) VALUES (?)", SlicedInt{1, 2})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	err = mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Unfulfilled expectations: %s", err)
+	}
+
+	var ids SlicedInt
+	err = ids.ScanWith(`{1,x}`, Options{Strict: true})
+	var elemErr *ElementError
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 {
+		t.Errorf("Expected an error on element 1, got '%v'", err)
+	}
+
+	err = ids.ScanWith("1; 2", Options{Slice: SliceOptions{Delimiter: ";", TrimSpace: true, Split: true}})
+	if err != nil || !reflect.DeepEqual(ids, SlicedInt{1, 2}) {
+		t.Errorf("ids %#v is not 1, 2: %v", ids, err)
+	}
+
+	err = ids.Scan(`{1,"2}`)
+	if !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s', got '%v'", ErrSyntax, err)
+	}
+}
+
+func TestSlicedDefaultElementError(t *testing.T) {
+	var i SlicedInt
+	err := json.Unmarshal([]byte(`[1, "x", 3]`), &i)
+	var elemErr *ElementError
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 || !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s' on element 1, got '%v'", ErrSyntax, err)
+	}
+
+	var b SlicedBool
+	err = json.Unmarshal([]byte(`[true, "no", "maybe"]`), &b)
+	if !errors.As(err, &elemErr) || elemErr.Index != 2 || !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s' on element 2, got '%v'", ErrSyntax, err)
+	}
+
+	var d SlicedDuration
+	err = d.Scan(`{1s,soon}`)
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 || !errors.Is(err, ErrSyntax) {
+		t.Errorf("Expected '%s' on element 1, got '%v'", ErrSyntax, err)
+	}
+}
+
+func TestSlicedDurationRoundTrip(t *testing.T) {
+	defer func() {
+		SlicedSQLFormat = SlicedPostgres
+		SlicedTextFormat = SlicedJoined
+	}()
+
+	d := SlicedDuration{time.Second, 2 * time.Second, -time.Millisecond, 0}
+	for _, f := range []SlicedFormat{SlicedArray, SlicedSingle, SlicedJoined, SlicedPostgres} {
+		SlicedSQLFormat = f
+		SlicedTextFormat = f
+
+		v, err := d.Value()
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", f, err)
+			continue
+		}
+
+		var result SlicedDuration
+		err = result.Scan(v)
+		if err != nil || !reflect.DeepEqual(result, d) {
+			t.Errorf("%d: sql %v gave %#v, expected %#v: %v", f, v, result, d, err)
+		}
+
+		text, err := d.MarshalText()
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", f, err)
+			continue
+		}
+
+		result = nil
+		err = result.UnmarshalText(text)
+		if err != nil || !reflect.DeepEqual(result, d) {
+			t.Errorf("%d: text %s gave %#v, expected %#v: %v", f, text, result, d, err)
+		}
+	}
+}
+
+func TestSlicedSQLFormats(t *testing.T) {
+	defer func() {
+		SlicedSQLFormat = SlicedPostgres
+	}()
+
+	values := []SlicedInt{SlicedInt{1, 2}, SlicedInt{3}}
+	for _, f := range []SlicedFormat{SlicedArray, SlicedSingle, SlicedJoined, SlicedPostgres} {
+		SlicedSQLFormat = f
+		for _, i := range values {
+			v, err := i.Value()
+			if err != nil {
+				t.Errorf("%d: %#v: unexpected error: %s", f, i, err)
+				continue
+			}
+
+			var result SlicedInt
+			err = result.Scan(v)
+			if err != nil || !reflect.DeepEqual(result, i) {
+				t.Errorf("%d: %v gave %#v, expected %#v: %v", f, v, result, i, err)
+			}
+		}
+	}
+}
+
+func TestSlicedJSONFormats(t *testing.T) {
+	defer func() {
+		SlicedJSONFormat = SlicedArray
+	}()
+
+	values := []SlicedInt{SlicedInt{1, 2}, SlicedInt{3}}
+	d := SlicedDuration{time.Second, -time.Millisecond}
+	for _, f := range []SlicedFormat{SlicedArray, SlicedSingle, SlicedJoined, SlicedPostgres} {
+		SlicedJSONFormat = f
+		for _, i := range values {
+			data, err := json.Marshal(i)
+			if err != nil {
+				t.Errorf("%d: %#v: unexpected error: %s", f, i, err)
+				continue
+			}
+
+			var result SlicedInt
+			err = json.Unmarshal(data, &result)
+			if err != nil || !reflect.DeepEqual(result, i) {
+				t.Errorf("%d: %s gave %#v, expected %#v: %v", f, data, result, i, err)
+			}
+		}
+
+		data, err := json.Marshal(d)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", f, err)
+			continue
+		}
+
+		var result SlicedDuration
+		err = json.Unmarshal(data, &result)
+		if err != nil || !reflect.DeepEqual(result, d) {
+			t.Errorf("%d: %s gave %#v, expected %#v: %v", f, data, result, d, err)
+		}
+	}
+}
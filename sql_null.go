@@ -0,0 +1,110 @@
+package extratypes
+
+import "database/sql"
+
+// ToNullBool returns b as a sql.NullBool
+func ToNullBool(b Bool) sql.NullBool {
+	return sql.NullBool{Bool: b.Val, Valid: !b.Nil}
+}
+
+// FromNullBool returns n as a Bool
+func FromNullBool(n sql.NullBool) Bool {
+	return Bool{Val: n.Bool, Nil: !n.Valid}
+}
+
+// toNullInteger converts n into D in strict mode, so a value that does
+// not fit into D returns an error with ReasonOverflow, or ReasonNegative
+// when D is unsigned.
+func toNullInteger[D any, T integer](n Integer[T]) (D, bool, error) {
+	var v D
+	if n.Nil {
+		return v, false, nil
+	}
+
+	_, err := Options{Strict: true}.toType(n.Val, &v)
+	if err != nil {
+		return v, false, err
+	}
+
+	return v, true, nil
+}
+
+// ToNullInt64 returns n as a sql.NullInt64. A value that does not fit into
+// int64, such as a big Uint64, returns an error with ReasonOverflow.
+func ToNullInt64[T integer](n Integer[T]) (sql.NullInt64, error) {
+	v, valid, err := toNullInteger[int64](n)
+	return sql.NullInt64{Int64: v, Valid: valid}, err
+}
+
+// FromNullInt64 returns n as an Int64
+func FromNullInt64(n sql.NullInt64) Int64 {
+	return Int64{Val: n.Int64, Nil: !n.Valid}
+}
+
+// ToNullInt32 returns n as a sql.NullInt32. A value that does not fit into
+// int32 returns an error with ReasonOverflow.
+func ToNullInt32[T integer](n Integer[T]) (sql.NullInt32, error) {
+	v, valid, err := toNullInteger[int32](n)
+	return sql.NullInt32{Int32: v, Valid: valid}, err
+}
+
+// FromNullInt32 returns n as an Int32
+func FromNullInt32(n sql.NullInt32) Int32 {
+	return Int32{Val: n.Int32, Nil: !n.Valid}
+}
+
+// ToNullInt16 returns n as a sql.NullInt16. A value that does not fit into
+// int16 returns an error with ReasonOverflow.
+func ToNullInt16[T integer](n Integer[T]) (sql.NullInt16, error) {
+	v, valid, err := toNullInteger[int16](n)
+	return sql.NullInt16{Int16: v, Valid: valid}, err
+}
+
+// FromNullInt16 returns n as an Int16
+func FromNullInt16(n sql.NullInt16) Int16 {
+	return Int16{Val: n.Int16, Nil: !n.Valid}
+}
+
+// ToNullByte returns n as a sql.NullByte. A value that does not fit into a
+// byte returns an error with ReasonOverflow, or ReasonNegative when it is
+// negative.
+func ToNullByte[T integer](n Integer[T]) (sql.NullByte, error) {
+	v, valid, err := toNullInteger[byte](n)
+	return sql.NullByte{Byte: v, Valid: valid}, err
+}
+
+// FromNullByte returns n as an Uint8
+func FromNullByte(n sql.NullByte) Uint8 {
+	return Uint8{Val: n.Byte, Nil: !n.Valid}
+}
+
+// ToNullFloat64 returns f, that is a Float32 or a Float64, as a
+// sql.NullFloat64
+func ToNullFloat64[T float32 | float64](f Null[T]) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: float64(f.Val), Valid: !f.Nil}
+}
+
+// FromNullFloat64 returns n as a Float64
+func FromNullFloat64(n sql.NullFloat64) Float64 {
+	return Float64{Val: n.Float64, Nil: !n.Valid}
+}
+
+// ToNullString returns s as a sql.NullString
+func ToNullString(s String) sql.NullString {
+	return sql.NullString{String: s.Val, Valid: !s.Nil}
+}
+
+// FromNullString returns n as a String
+func FromNullString(n sql.NullString) String {
+	return String{Val: n.String, Nil: !n.Valid}
+}
+
+// ToNullTime returns t as a sql.NullTime
+func ToNullTime(t Time) sql.NullTime {
+	return sql.NullTime{Time: t.Time, Valid: !t.Nil}
+}
+
+// FromNullTime returns n as a Time
+func FromNullTime(n sql.NullTime) Time {
+	return Time{Time: n.Time, Nil: !n.Valid}
+}
@@ -0,0 +1,107 @@
+package extratypes
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNullBool(t *testing.T) {
+	type toCheck = struct {
+		b Bool
+		n sql.NullBool
+	}
+
+	checks := []toCheck{
+		toCheck{b: Bool{Val: true}, n: sql.NullBool{Bool: true, Valid: true}},
+		toCheck{b: Bool{Val: false}, n: sql.NullBool{Bool: false, Valid: true}},
+		toCheck{b: Bool{Nil: true}, n: sql.NullBool{}},
+	}
+
+	for _, check := range checks {
+		if n := ToNullBool(check.b); n != check.n {
+			t.Errorf("%+v gave %+v, expected %+v", check.b, n, check.n)
+		}
+
+		if b := FromNullBool(check.n); b != check.b {
+			t.Errorf("%+v gave %+v, expected %+v", check.n, b, check.b)
+		}
+	}
+}
+
+func TestNullInt(t *testing.T) {
+	n, err := ToNullInt64(Uint32{Val: 42})
+	if err != nil || n != (sql.NullInt64{Int64: 42, Valid: true}) {
+		t.Errorf("n %+v is not 42: %v", n, err)
+	}
+
+	n, err = ToNullInt64(Uint64{Val: math.MaxUint64})
+	if !errors.Is(err, ErrOverflow) || n.Valid {
+		t.Errorf("n %+v expected '%s', got '%v'", n, ErrOverflow, err)
+	}
+
+	n, err = ToNullInt64(Int{Nil: true})
+	if err != nil || n.Valid {
+		t.Errorf("n %+v is not nil: %v", n, err)
+	}
+
+	if i := FromNullInt64(sql.NullInt64{Int64: -5, Valid: true}); i != (Int64{Val: -5}) {
+		t.Errorf("i %+v is not -5", i)
+	}
+
+	n32, err := ToNullInt32(Int64{Val: math.MaxInt32 + 1})
+	if !errors.Is(err, ErrOverflow) || n32.Valid {
+		t.Errorf("n32 %+v expected '%s', got '%v'", n32, ErrOverflow, err)
+	}
+
+	if i := FromNullInt32(sql.NullInt32{}); !i.Nil {
+		t.Errorf("i %+v is not nil", i)
+	}
+
+	n16, err := ToNullInt16(Int8{Val: -8})
+	if err != nil || n16 != (sql.NullInt16{Int16: -8, Valid: true}) {
+		t.Errorf("n16 %+v is not -8: %v", n16, err)
+	}
+
+	if i := FromNullInt16(sql.NullInt16{Int16: 16, Valid: true}); i != (Int16{Val: 16}) {
+		t.Errorf("i %+v is not 16", i)
+	}
+
+	nb, err := ToNullByte(Int{Val: -1})
+	if !errors.Is(err, ErrNegative) || nb.Valid {
+		t.Errorf("nb %+v expected '%s', got '%v'", nb, ErrNegative, err)
+	}
+
+	if i := FromNullByte(sql.NullByte{Byte: 255, Valid: true}); i != (Uint8{Val: 255}) {
+		t.Errorf("i %+v is not 255", i)
+	}
+}
+
+func TestNullFloatStringTime(t *testing.T) {
+	if f := ToNullFloat64(Float32{Val: 1.5}); f != (sql.NullFloat64{Float64: 1.5, Valid: true}) {
+		t.Errorf("f %+v is not 1.5", f)
+	}
+
+	if f := FromNullFloat64(sql.NullFloat64{}); !f.Nil {
+		t.Errorf("f %+v is not nil", f)
+	}
+
+	if s := ToNullString(String{Val: ""}); s != (sql.NullString{Valid: true}) {
+		t.Errorf("s %+v is not an empty string", s)
+	}
+
+	if s := FromNullString(sql.NullString{String: "a", Valid: true}); s != (String{Val: "a"}) {
+		t.Errorf("s %+v is not a", s)
+	}
+
+	now := time.Now()
+	if n := ToNullTime(Time{Time: now}); !n.Valid || !n.Time.Equal(now) {
+		t.Errorf("n %+v is not %s", n, now)
+	}
+
+	if tm := FromNullTime(sql.NullTime{}); !tm.Nil {
+		t.Errorf("tm %+v is not nil", tm)
+	}
+}
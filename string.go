@@ -0,0 +1,9 @@
+package extratypes
+
+// String contains string data type that can be null. The value can also
+// be a number, a boolean or a slice of bytes on JSON and SQL, and it will
+// be converted into its string form, so 123 and "123" are the same.
+//
+// A JSON null and a SQL NULL are nil, while "" is an empty string.
+// Text has no null, so every text (including an empty one) is a value.
+type String = Null[string]
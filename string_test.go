@@ -0,0 +1,232 @@
+package extratypes
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validString = String{
+		Val: "123",
+		Nil: false,
+	}
+
+	emptyString = String{
+		Val: "",
+		Nil: false,
+	}
+
+	nilString = String{
+		Val: "",
+		Nil: true,
+	}
+)
+
+var (
+	testStringJSON      = []byte(`"123"`)
+	testStringEmptyJSON = []byte(`""`)
+	testStringNilJSON   = []byte("null")
+	testStringErrJSON   = []byte("a")
+	testStringValidText = []byte("123")
+)
+
+func TestStringString(t *testing.T) {
+	if validString.String() != "123" {
+		t.Errorf("s [%s] is not 123", validString)
+	}
+
+	if nilString.String() != "nil" {
+		t.Errorf("s [%s] is not nil", nilString)
+	}
+}
+
+func TestStringScan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"s"}).
+			AddRow("123").
+			AddRow([]byte("123")).
+			AddRow(123).
+			AddRow(uint64(123))
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var s String
+			err := rs.Scan(&s)
+			if err != nil {
+				te.Errorf("Unable to scan String: %s", err)
+			}
+			if !reflect.DeepEqual(s, validString) {
+				te.Errorf("s %+v is not %+v", s, validString)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil and empty", func(te *testing.T) {
+		rows := mock.NewRows([]string{"s"}).
+			AddRow(nil).
+			AddRow("")
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+
+		expected := []String{nilString, emptyString}
+		i := 0
+		for rs.Next() {
+			var s String
+			err := rs.Scan(&s)
+			if err != nil {
+				te.Errorf("Unable to scan String: %s", err)
+			}
+			if !reflect.DeepEqual(s, expected[i]) {
+				te.Errorf("s %+v is not %+v", s, expected[i])
+			}
+			i++
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestStringValue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validString, emptyString, nilString).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (s)", validString, emptyString, nilString)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestStringJSONMarshal(t *testing.T) {
+	type toCheck = struct {
+		s        String
+		expected []byte
+	}
+
+	checks := []toCheck{
+		toCheck{s: validString, expected: testStringJSON},
+		toCheck{s: emptyString, expected: testStringEmptyJSON},
+		toCheck{s: nilString, expected: testStringNilJSON},
+	}
+
+	for _, check := range checks {
+		result, err := check.s.MarshalJSON()
+		if err != nil {
+			t.Errorf("Error marshaling String to JSON: %s", err)
+			continue
+		}
+
+		if !bytes.Equal(result, check.expected) {
+			t.Errorf("Expected '%s', got '%s'", check.expected, result)
+		}
+	}
+}
+
+func TestStringJSONUnmarshal(t *testing.T) {
+	type toCheck = struct {
+		b        []byte
+		expected String
+	}
+
+	checks := []toCheck{
+		toCheck{b: testStringJSON, expected: validString},
+		toCheck{b: []byte("123"), expected: validString},
+		toCheck{b: []byte("12345678901234567890123"), expected: String{Val: "12345678901234567890123"}},
+		toCheck{b: []byte("1.50"), expected: String{Val: "1.50"}},
+		toCheck{b: []byte("true"), expected: String{Val: "true"}},
+		toCheck{b: testStringEmptyJSON, expected: emptyString},
+		toCheck{b: testStringNilJSON, expected: nilString},
+	}
+
+	for _, check := range checks {
+		result := String{Val: "old"}
+		err := result.UnmarshalJSON(check.b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.b, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, check.expected) {
+			t.Errorf("%s: result %+v not equal to %+v", check.b, result, check.expected)
+		}
+	}
+
+	var result String
+	err := result.UnmarshalJSON(testStringErrJSON)
+	if err == nil {
+		t.Errorf("expected error, but none given")
+	}
+
+	for _, b := range [][]byte{[]byte(`{"a":1}`), []byte(`[1,2]`)} {
+		result = String{Val: "old"}
+		err = result.UnmarshalJSON(b)
+		if !errors.Is(err, ErrUnsupported) || result.Val != "old" {
+			t.Errorf("%s: result %+v expected '%s', got '%v'", b, result, ErrUnsupported, err)
+		}
+	}
+}
+
+func TestStringTextMarshal(t *testing.T) {
+	b, err := validString.MarshalText()
+	if err != nil {
+		t.Errorf("Unexpected err: %s", err)
+	}
+
+	if !bytes.Equal(b, testStringValidText) {
+		t.Errorf("b %s is not %s", b, testStringValidText)
+	}
+}
+
+func TestStringTextUnmarshal(t *testing.T) {
+	type toCheck = struct {
+		b        []byte
+		expected String
+	}
+
+	checks := []toCheck{
+		toCheck{b: testStringValidText, expected: validString},
+		toCheck{b: []byte(""), expected: emptyString},
+		toCheck{b: []byte("null"), expected: String{Val: "null"}},
+		toCheck{b: nil, expected: nilString},
+	}
+
+	for _, check := range checks {
+		var result String
+		err := result.UnmarshalText(check.b)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", check.b, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, check.expected) {
+			t.Errorf("%q: result %+v not equal to %+v", check.b, result, check.expected)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package extratypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Time is wrapper for time.Time that can be null. It can be read from a
+// string in many layouts, or from a Unix epoch on JSON, Text and SQL.
+type Time struct {
+	time.Time
+	Nil bool
+}
+
+var (
+	// TimeLayout is the layout that Time is written with on JSON and Text
+	TimeLayout = time.RFC3339Nano
+
+	// TimeLayouts are the layouts that are tried in order when a Time is
+	// parsed from a string. A layout without a time zone is parsed as UTC.
+	TimeLayouts = []string{
+		time.RFC3339Nano,
+		time.RFC1123Z,
+		time.RFC1123,
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999 -0700",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02",
+		time.RFC850,
+		time.RubyDate,
+		time.UnixDate,
+		time.ANSIC,
+	}
+
+	timeType = reflect.TypeOf(Time{})
+)
+
+// The magnitude limits of an epoch, that are used to detect its
+// precision. Seconds are used up to the year 5138, milliseconds up to the
+// same year and so on, so any epoch since 1973 is detected correctly.
+const (
+	maxEpochSeconds = 1e11
+	maxEpochMillis  = 1e14
+	maxEpochMicros  = 1e17
+)
+
+func (t Time) String() string {
+	if t.Nil {
+		return "nil"
+	}
+
+	return t.Time.Format(TimeLayout)
+}
+
+// Value implements the driver Valuer interface.
+func (t Time) Value() (driver.Value, error) {
+	if t.Nil {
+		return nil, nil
+	}
+
+	return t.Time, nil
+}
+
+// Scan implements the Scanner interface.
+func (t *Time) Scan(v interface{}) error {
+	switch val := v.(type) {
+	case nil:
+		t.Time = time.Time{}
+		t.Nil = true
+		return nil
+	case string:
+		if strings.TrimSpace(val) == "" {
+			return t.Scan(nil)
+		}
+	case []byte:
+		if len(bytes.TrimSpace(val)) == 0 {
+			return t.Scan(nil)
+		}
+	}
+
+	val, err := asTime(v)
+	if err != nil {
+		return asConversionError(v, timeType, ReasonSyntax, err)
+	}
+
+	t.Time = val
+	t.Nil = false
+	return nil
+}
+
+// MarshalJSON takes a Time and marshal it as a string in TimeLayout
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.Nil {
+		return json.Marshal(nil)
+	}
+
+	return json.Marshal(t.Time.Format(TimeLayout))
+}
+
+// UnmarshalJSON takes a string in one of TimeLayouts, an epoch or null and
+// converts it to Time
+func (t *Time) UnmarshalJSON(b []byte) error {
+	v, err := decodeJSON(b)
+	if err != nil {
+		return newConversionError(b, timeType, ReasonSyntax, err)
+	}
+
+	return t.Scan(fromJSONNumber(v))
+}
+
+// MarshalText takes a Time and marshal it as a string in TimeLayout
+func (t Time) MarshalText() ([]byte, error) {
+	if t.Nil {
+		return []byte(""), nil
+	}
+
+	return []byte(t.Time.Format(TimeLayout)), nil
+}
+
+// UnmarshalText takes a string in one of TimeLayouts or an epoch and
+// converts it to Time. An empty text is nil.
+func (t *Time) UnmarshalText(b []byte) error {
+	return t.Scan(string(b))
+}
+
+// asTime converts src into time.Time. A string is parsed by TimeLayouts,
+// or as an epoch if it is a number, and a number is an epoch.
+func asTime(src interface{}) (time.Time, error) {
+	switch v := src.(type) {
+	case time.Time:
+		return v, nil
+	case []byte:
+		return asTime(string(v))
+	}
+
+	val := reflect.ValueOf(src)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fromEpoch(val.Int(), 0), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := val.Uint()
+		if u > math.MaxInt64 {
+			return time.Time{}, ErrOverflow
+		}
+		return fromEpoch(int64(u), 0), nil
+	case reflect.Float32, reflect.Float64:
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) || math.Abs(f) >= math.MaxInt64 {
+			return time.Time{}, ErrOverflow
+		}
+		whole, frac := math.Modf(f)
+		return fromEpoch(int64(whole), frac), nil
+	case reflect.String:
+		return parseTime(strings.TrimSpace(val.String()))
+	}
+
+	return time.Time{}, ErrUnsupported
+}
+
+// parseTime parses s by TimeLayouts, or as an epoch when s is a number
+func parseTime(s string) (time.Time, error) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return fromEpoch(i, 0), nil
+	}
+
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return asTime(f)
+	}
+
+	return parseLayouts(s, TimeLayouts)
+}
+
+// parseLayouts parses s by the first of layouts that matches it
+func parseLayouts(s string, layouts []string) (time.Time, error) {
+	var firstErr error
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if firstErr == nil {
+		return time.Time{}, ErrSyntax
+	}
+	return time.Time{}, firstErr
+}
+
+// fromEpoch creates a time from whole units of epoch, and the fraction
+// of the next unit. The unit (seconds, milliseconds, microseconds or
+// nanoseconds) is detected from the magnitude of whole.
+func fromEpoch(whole int64, frac float64) time.Time {
+	abs := math.Abs(float64(whole))
+	switch {
+	case abs < maxEpochSeconds:
+		return time.Unix(whole, int64(frac*float64(time.Second))).UTC()
+	case abs < maxEpochMillis:
+		return time.UnixMilli(whole).Add(time.Duration(frac * float64(time.Millisecond))).UTC()
+	case abs < maxEpochMicros:
+		return time.UnixMicro(whole).Add(time.Duration(frac * float64(time.Microsecond))).UTC()
+	}
+
+	return time.Unix(0, whole).UTC()
+}
@@ -0,0 +1,228 @@
+package extratypes
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// TimeOfDay is a time of the day without a date and a time zone, such as
+// an SQL TIME column, that can be null.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+	Nil        bool
+}
+
+var (
+	// TimeOfDayLayout is the layout that TimeOfDay is written with on JSON,
+	// Text and SQL. A fraction of a second is written only when it is set.
+	TimeOfDayLayout = "15:04:05.999999999"
+
+	// TimeOfDayLayouts are the layouts that are tried in order when a
+	// TimeOfDay is parsed from a string, before TimeLayouts.
+	TimeOfDayLayouts = []string{
+		"15:04:05.999999999",
+		"15:04",
+		"3:04:05PM",
+		"3:04PM",
+	}
+
+	timeOfDayType = reflect.TypeOf(TimeOfDay{})
+)
+
+const oneDay = 24 * time.Hour
+
+// NewTimeOfDay returns the TimeOfDay of hour, min, sec and nsec. Values out
+// of their range are normalized, and wrap around midnight.
+func NewTimeOfDay(hour, min, sec, nsec int) TimeOfDay {
+	d := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(nsec)
+	return timeOfDaySince(d)
+}
+
+// TimeOfDayOf returns the TimeOfDay of t, in the location of t
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	var tod TimeOfDay
+	tod.Hour, tod.Minute, tod.Second = t.Clock()
+	tod.Nanosecond = t.Nanosecond()
+	return tod
+}
+
+// timeOfDaySince returns the TimeOfDay that is d after midnight, d is
+// wrapped into a single day.
+func timeOfDaySince(d time.Duration) TimeOfDay {
+	d %= oneDay
+	if d < 0 {
+		d += oneDay
+	}
+
+	return TimeOfDay{
+		Hour:       int(d / time.Hour),
+		Minute:     int(d % time.Hour / time.Minute),
+		Second:     int(d % time.Minute / time.Second),
+		Nanosecond: int(d % time.Second),
+	}
+}
+
+// On returns the time of t at the Date d in loc
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	return d.At(t, loc)
+}
+
+// Duration returns the time that passed since midnight
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// Add returns t with d added to it, wrapped around midnight. A nil
+// TimeOfDay stays nil.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	if t.Nil {
+		return t
+	}
+
+	return timeOfDaySince(t.Duration() + d%oneDay)
+}
+
+// Sub returns the duration from u to t, it is 0 when one of them is nil.
+func (t TimeOfDay) Sub(u TimeOfDay) time.Duration {
+	if t.Nil || u.Nil {
+		return 0
+	}
+
+	return t.Duration() - u.Duration()
+}
+
+// Compare returns -1 when t is before u, 1 when t is after u and 0 when
+// they are equal. A nil TimeOfDay is before any other TimeOfDay.
+func (t TimeOfDay) Compare(u TimeOfDay) int {
+	switch {
+	case t.Nil && u.Nil:
+		return 0
+	case t.Nil:
+		return -1
+	case u.Nil:
+		return 1
+	}
+
+	d := t.Sub(u)
+	switch {
+	case d < 0:
+		return -1
+	case d > 0:
+		return 1
+	}
+
+	return 0
+}
+
+// Before reports whether t is before u
+func (t TimeOfDay) Before(u TimeOfDay) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether t is after u
+func (t TimeOfDay) After(u TimeOfDay) bool {
+	return t.Compare(u) > 0
+}
+
+// Equal reports whether t and u are the same time of the day, or both nil
+func (t TimeOfDay) Equal(u TimeOfDay) bool {
+	return t.Compare(u) == 0
+}
+
+func (t TimeOfDay) String() string {
+	if t.Nil {
+		return "nil"
+	}
+
+	return time.Date(0, time.January, 1, t.Hour, t.Minute, t.Second,
+		t.Nanosecond, time.UTC).Format(TimeOfDayLayout)
+}
+
+// Value implements the driver Valuer interface.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	if t.Nil {
+		return nil, nil
+	}
+
+	return t.String(), nil
+}
+
+// Scan implements the Scanner interface. A time.Time gives its clock in
+// its own location, and a time.Duration is the time since midnight.
+func (t *TimeOfDay) Scan(v interface{}) error {
+	var s string
+	switch val := v.(type) {
+	case nil:
+		*t = TimeOfDay{Nil: true}
+		return nil
+	case time.Time:
+		*t = TimeOfDayOf(val)
+		return nil
+	case time.Duration:
+		*t = timeOfDaySince(val)
+		return nil
+	case string:
+		s = val
+	case []byte:
+		s = string(val)
+	default:
+		return newConversionError(v, timeOfDayType, ReasonUnsupported, nil)
+	}
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return t.Scan(nil)
+	}
+
+	tm, err := parseLayouts(s, append(append([]string(nil), TimeOfDayLayouts...), TimeLayouts...))
+	if err != nil {
+		return newConversionError(v, timeOfDayType, ReasonSyntax, err)
+	}
+
+	*t = TimeOfDayOf(tm)
+	return nil
+}
+
+// MarshalJSON takes a TimeOfDay and marshal it as a string in
+// TimeOfDayLayout
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	if t.Nil {
+		return json.Marshal(nil)
+	}
+
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON takes a string or null and converts it to TimeOfDay
+func (t *TimeOfDay) UnmarshalJSON(b []byte) error {
+	v, err := decodeJSON(b)
+	if err != nil {
+		return newConversionError(b, timeOfDayType, ReasonSyntax, err)
+	}
+
+	return t.Scan(fromJSONNumber(v))
+}
+
+// MarshalText takes a TimeOfDay and marshal it as a string in
+// TimeOfDayLayout
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	if t.Nil {
+		return []byte(""), nil
+	}
+
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText takes a string and converts it to TimeOfDay. An empty text
+// is nil.
+func (t *TimeOfDay) UnmarshalText(b []byte) error {
+	return t.Scan(string(b))
+}
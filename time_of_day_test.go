@@ -0,0 +1,256 @@
+package extratypes
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validTimeOfDay = TimeOfDay{
+		Hour:   10,
+		Minute: 30,
+		Second: 5,
+		Nil:    false,
+	}
+
+	nilTimeOfDay = TimeOfDay{
+		Nil: true,
+	}
+)
+
+var (
+	testTimeOfDayJSON      = []byte(`"10:30:05"`)
+	testTimeOfDayNilJSON   = []byte(`null`)
+	testTimeOfDayValidText = []byte(`10:30:05`)
+	testTimeOfDayNilText   = []byte(``)
+)
+
+func TestTimeOfDayString(t *testing.T) {
+	if validTimeOfDay.String() != string(testTimeOfDayValidText) {
+		t.Errorf("t [%s] is not %s", validTimeOfDay, testTimeOfDayValidText)
+	}
+
+	tod := NewTimeOfDay(10, 30, 5, 500000000)
+	if tod.String() != "10:30:05.5" {
+		t.Errorf("t [%s] is not 10:30:05.5", tod)
+	}
+
+	if nilTimeOfDay.String() != "nil" {
+		t.Errorf("t [%s] is not nil", nilTimeOfDay)
+	}
+}
+
+func TestTimeOfDayScan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		loc := time.FixedZone("UTC+3", 3*60*60)
+		rows := mock.NewRows([]string{"t"}).
+			AddRow(time.Date(0, time.January, 1, 10, 30, 5, 0, time.UTC)).
+			AddRow(time.Date(2020, time.May, 17, 10, 30, 5, 0, loc)).
+			AddRow("10:30:05").
+			AddRow([]byte("10:30:05")).
+			AddRow("10:30:05AM").
+			AddRow("2020-05-17 10:30:05")
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var tod TimeOfDay
+			err := rs.Scan(&tod)
+			if err != nil {
+				te.Errorf("Unable to scan TimeOfDay: %s", err)
+			}
+			if !reflect.DeepEqual(tod, validTimeOfDay) {
+				te.Errorf("tod %+v is not %+v", tod, validTimeOfDay)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"t"}).
+			AddRow(nil).
+			AddRow(" ")
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			tod := validTimeOfDay
+			err := rs.Scan(&tod)
+			if err != nil {
+				te.Errorf("Unable to scan TimeOfDay: %s", err)
+			}
+			if !reflect.DeepEqual(tod, nilTimeOfDay) {
+				te.Errorf("tod %+v expected to be nil", tod)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test duration", func(te *testing.T) {
+		var tod TimeOfDay
+		err := tod.Scan(10*time.Hour + 30*time.Minute + 5*time.Second)
+		if err != nil || !reflect.DeepEqual(tod, validTimeOfDay) {
+			te.Errorf("tod %+v is not %+v: %v", tod, validTimeOfDay, err)
+		}
+	})
+
+	t.Run("test invalid", func(te *testing.T) {
+		var tod TimeOfDay
+		err := tod.Scan("25:00")
+		if !errors.Is(err, ErrSyntax) {
+			te.Errorf("Expected '%s', got %v", ErrSyntax, err)
+		}
+
+		err = tod.Scan(true)
+		if !errors.Is(err, ErrUnsupported) {
+			te.Errorf("Expected '%s', got %v", ErrUnsupported, err)
+		}
+	})
+}
+
+func TestTimeOfDayValue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs("10:30:05", nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (t)", validTimeOfDay, nilTimeOfDay)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestTimeOfDayArithmetic(t *testing.T) {
+	type toCheck = struct {
+		tod      TimeOfDay
+		expected TimeOfDay
+	}
+
+	checks := []toCheck{
+		toCheck{tod: NewTimeOfDay(10, 29, 65, 0), expected: validTimeOfDay},
+		toCheck{tod: NewTimeOfDay(-1, 0, 0, 0), expected: NewTimeOfDay(23, 0, 0, 0)},
+		toCheck{tod: validTimeOfDay.Add(14 * time.Hour), expected: NewTimeOfDay(0, 30, 5, 0)},
+		toCheck{tod: validTimeOfDay.Add(-11 * time.Hour), expected: NewTimeOfDay(23, 30, 5, 0)},
+		toCheck{tod: validTimeOfDay.Add(48*time.Hour + time.Second), expected: NewTimeOfDay(10, 30, 6, 0)},
+		toCheck{tod: nilTimeOfDay.Add(time.Hour), expected: nilTimeOfDay},
+	}
+
+	for _, check := range checks {
+		if !reflect.DeepEqual(check.tod, check.expected) {
+			t.Errorf("tod %s is not %s", check.tod, check.expected)
+		}
+	}
+
+	if d := validTimeOfDay.Sub(NewTimeOfDay(12, 0, 0, 0)); d != -(89*time.Minute + 55*time.Second) {
+		t.Errorf("d %s is not -1h29m55s", d)
+	}
+
+	if d := validTimeOfDay.Duration(); d != 10*time.Hour+30*time.Minute+5*time.Second {
+		t.Errorf("d %s is not 10h30m5s", d)
+	}
+}
+
+func TestTimeOfDayCompare(t *testing.T) {
+	later := validTimeOfDay.Add(time.Nanosecond)
+
+	if !validTimeOfDay.Before(later) || validTimeOfDay.After(later) || !later.After(validTimeOfDay) {
+		t.Errorf("%s is expected to be before %s", validTimeOfDay, later)
+	}
+
+	if !validTimeOfDay.Equal(NewTimeOfDay(10, 30, 5, 0)) {
+		t.Errorf("%s is expected to be equal", validTimeOfDay)
+	}
+
+	if !nilTimeOfDay.Before(validTimeOfDay) || !nilTimeOfDay.Equal(TimeOfDay{Nil: true}) {
+		t.Errorf("nil is expected to be before any time of day")
+	}
+}
+
+func TestTimeOfDayJSON(t *testing.T) {
+	result, err := validTimeOfDay.MarshalJSON()
+	if err != nil || !bytes.Equal(result, testTimeOfDayJSON) {
+		t.Errorf("Expected '%s', got '%s': %v", testTimeOfDayJSON, result, err)
+	}
+
+	result, err = nilTimeOfDay.MarshalJSON()
+	if err != nil || !bytes.Equal(result, testTimeOfDayNilJSON) {
+		t.Errorf("Expected '%s', got '%s': %v", testTimeOfDayNilJSON, result, err)
+	}
+
+	type toCheck = struct {
+		b        []byte
+		expected TimeOfDay
+	}
+
+	checks := []toCheck{
+		toCheck{b: testTimeOfDayJSON, expected: validTimeOfDay},
+		toCheck{b: []byte(`"10:30"`), expected: NewTimeOfDay(10, 30, 0, 0)},
+		toCheck{b: testTimeOfDayNilJSON, expected: nilTimeOfDay},
+	}
+
+	for _, check := range checks {
+		var tod TimeOfDay
+		err := tod.UnmarshalJSON(check.b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.b, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(tod, check.expected) {
+			t.Errorf("%s: result %+v not equal to %+v", check.b, tod, check.expected)
+		}
+	}
+
+	var tod TimeOfDay
+	if err := tod.UnmarshalJSON([]byte("1")); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Expected '%s', got %v", ErrUnsupported, err)
+	}
+}
+
+func TestTimeOfDayText(t *testing.T) {
+	b, err := validTimeOfDay.MarshalText()
+	if err != nil || !bytes.Equal(b, testTimeOfDayValidText) {
+		t.Errorf("b %s is not %s: %v", b, testTimeOfDayValidText, err)
+	}
+
+	b, err = nilTimeOfDay.MarshalText()
+	if err != nil || !bytes.Equal(b, testTimeOfDayNilText) {
+		t.Errorf("b %s is not %s: %v", b, testTimeOfDayNilText, err)
+	}
+
+	var tod TimeOfDay
+	err = tod.UnmarshalText(testTimeOfDayValidText)
+	if err != nil || !reflect.DeepEqual(tod, validTimeOfDay) {
+		t.Errorf("tod %+v is not %+v: %v", tod, validTimeOfDay, err)
+	}
+
+	err = tod.UnmarshalText(testTimeOfDayNilText)
+	if err != nil || !reflect.DeepEqual(tod, nilTimeOfDay) {
+		t.Errorf("tod %+v expected to be nil: %v", tod, err)
+	}
+}
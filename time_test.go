@@ -0,0 +1,278 @@
+package extratypes
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validTime = Time{
+		Time: time.Date(2020, time.May, 17, 10, 30, 0, 0, time.UTC),
+		Nil:  false,
+	}
+
+	nilTime = Time{
+		Nil: true,
+	}
+)
+
+var (
+	testTimeJSON      = []byte(`"2020-05-17T10:30:00Z"`)
+	testTimeNilJSON   = []byte(`null`)
+	testTimeValidText = []byte(`2020-05-17T10:30:00Z`)
+	testTimeNilText   = []byte(``)
+)
+
+func TestTimeString(t *testing.T) {
+	if validTime.String() != string(testTimeValidText) {
+		t.Errorf("t [%s] is not %s", validTime, testTimeValidText)
+	}
+
+	if nilTime.String() != "nil" {
+		t.Errorf("t [%s] is not nil", nilTime)
+	}
+}
+
+func TestTimeScan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"t"}).
+			AddRow(validTime.Time).
+			AddRow("2020-05-17 10:30:00").
+			AddRow([]byte("2020-05-17T10:30:00Z")).
+			AddRow(int64(1589711400))
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var tm Time
+			err := rs.Scan(&tm)
+			if err != nil {
+				te.Errorf("Unable to scan Time: %s", err)
+			}
+			if !tm.Equal(validTime.Time) || tm.Nil {
+				te.Errorf("tm %+v is not %+v", tm, validTime)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"t"}).
+			AddRow(nil).
+			AddRow("")
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			tm := validTime
+			err := rs.Scan(&tm)
+			if err != nil {
+				te.Errorf("Unable to scan Time: %s", err)
+			}
+			if !reflect.DeepEqual(tm, nilTime) {
+				te.Errorf("tm %+v expected to be nil", tm)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test invalid", func(te *testing.T) {
+		var tm Time
+		err := tm.Scan("yesterday")
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || convErr.Reason != ReasonSyntax {
+			te.Errorf("Expected ConversionError with syntax reason, got %v", err)
+		}
+
+		err = tm.Scan(true)
+		if !errors.Is(err, ErrUnsupported) {
+			te.Errorf("Expected '%s', got %v", ErrUnsupported, err)
+		}
+	})
+}
+
+func TestTimeValue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validTime, nilTime).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (t)", validTime, nilTime)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestTimeLayouts(t *testing.T) {
+	checks := []string{
+		"2020-05-17T10:30:00Z",
+		"2020-05-17T12:30:00+02:00",
+		"Sun, 17 May 2020 10:30:00 UTC",
+		"Sun, 17 May 2020 12:30:00 +0200",
+		"2020-05-17 10:30:00",
+		"2020-05-17 10:30:00.000",
+		"2020-05-17T10:30:00",
+		"1589711400",
+		"1589711400000",
+		"1589711400000000",
+		"1589711400000000000",
+		"1589711400.0",
+	}
+
+	for _, check := range checks {
+		var tm Time
+		err := tm.UnmarshalText([]byte(check))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check, err)
+			continue
+		}
+
+		if !tm.Equal(validTime.Time) {
+			t.Errorf("%s: %s is not %s", check, tm.Time, validTime.Time)
+		}
+	}
+
+	var tm Time
+	err := tm.UnmarshalText([]byte("2020-05-17"))
+	if err != nil || !tm.Equal(time.Date(2020, time.May, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("date only: %s, err: %v", tm, err)
+	}
+}
+
+func TestTimeEpoch(t *testing.T) {
+	type toCheck = struct {
+		src      interface{}
+		expected time.Time
+	}
+
+	checks := []toCheck{
+		toCheck{src: int64(1589711400), expected: validTime.Time},
+		toCheck{src: int64(1589711400123), expected: validTime.Add(123 * time.Millisecond)},
+		toCheck{src: int64(1589711400123456), expected: validTime.Add(123456 * time.Microsecond)},
+		toCheck{src: int64(1589711400123456789), expected: validTime.Add(123456789)},
+		toCheck{src: 1589711400.5, expected: validTime.Add(500 * time.Millisecond)},
+		toCheck{src: 0, expected: time.Unix(0, 0).UTC()},
+		toCheck{src: -86400, expected: time.Unix(-86400, 0).UTC()},
+	}
+
+	for _, check := range checks {
+		var tm Time
+		err := tm.Scan(check.src)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %s", check.src, err)
+			continue
+		}
+
+		if !tm.Equal(check.expected) {
+			t.Errorf("%v: %s is not %s", check.src, tm.Time, check.expected)
+		}
+	}
+}
+
+func TestTimeJSONMarshal(t *testing.T) {
+	result, err := validTime.MarshalJSON()
+	if err != nil {
+		t.Errorf("Error marshaling Time to JSON: %s", err)
+	}
+
+	if !bytes.Equal(result, testTimeJSON) {
+		t.Errorf("Expected '%s', got '%s'", testTimeJSON, result)
+	}
+
+	result, err = nilTime.MarshalJSON()
+	if err != nil {
+		t.Errorf("Error marshaling Time to JSON: %s", err)
+	}
+
+	if !bytes.Equal(result, testTimeNilJSON) {
+		t.Errorf("Expected '%s', got '%s'", testTimeNilJSON, result)
+	}
+
+	TimeLayout = "2006-01-02 15:04:05"
+	defer func() { TimeLayout = time.RFC3339Nano }()
+
+	result, err = validTime.MarshalJSON()
+	if err != nil {
+		t.Errorf("Error marshaling Time to JSON: %s", err)
+	}
+
+	if !bytes.Equal(result, []byte(`"2020-05-17 10:30:00"`)) {
+		t.Errorf("Unexpected layout: %s", result)
+	}
+}
+
+func TestTimeJSONUnmarshal(t *testing.T) {
+	type toCheck = struct {
+		b        []byte
+		expected Time
+	}
+
+	checks := []toCheck{
+		toCheck{b: testTimeJSON, expected: validTime},
+		toCheck{b: []byte("1589711400"), expected: validTime},
+		toCheck{b: []byte(`"1589711400000"`), expected: validTime},
+		toCheck{b: testTimeNilJSON, expected: nilTime},
+		toCheck{b: []byte(`""`), expected: nilTime},
+	}
+
+	for _, check := range checks {
+		var result Time
+		err := result.UnmarshalJSON(check.b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", check.b, err)
+			continue
+		}
+
+		if !result.Equal(check.expected.Time) || result.Nil != check.expected.Nil {
+			t.Errorf("%s: result %+v not equal to %+v", check.b, result, check.expected)
+		}
+	}
+
+	var result Time
+	if err := result.UnmarshalJSON([]byte("a")); err == nil {
+		t.Errorf("expected error, but none given")
+	}
+}
+
+func TestTimeText(t *testing.T) {
+	b, err := validTime.MarshalText()
+	if err != nil || !bytes.Equal(b, testTimeValidText) {
+		t.Errorf("b %s is not %s: %v", b, testTimeValidText, err)
+	}
+
+	b, err = nilTime.MarshalText()
+	if err != nil || !bytes.Equal(b, testTimeNilText) {
+		t.Errorf("b %s is not %s: %v", b, testTimeNilText, err)
+	}
+
+	var result Time
+	err = result.UnmarshalText(testTimeNilText)
+	if err != nil || !result.Nil {
+		t.Errorf("result %+v expected to be nil: %v", result, err)
+	}
+}
@@ -0,0 +1,24 @@
+package extratypes
+
+// Uint contains uint data type that can be null, and also string
+// on JSON and SQL, but value will be converted to uint type.
+// driver.Value cannot hold an unsigned 64 bit number, so a value bigger
+// than math.MaxInt64 is sent to the database as a string
+type Uint = Integer[uint]
+
+// Uint8 contains uint8 data type that can be null, and also string
+// on JSON and SQL, but value will be converted to uint8 type
+type Uint8 = Integer[uint8]
+
+// Uint16 contains uint16 data type that can be null, and also string
+// on JSON and SQL, but value will be converted to uint16 type
+type Uint16 = Integer[uint16]
+
+// Uint32 contains uint32 data type that can be null, and also string
+// on JSON and SQL, but value will be converted to uint32 type
+type Uint32 = Integer[uint32]
+
+// Uint64 contains uint64 data type that can be null, and also string
+// on JSON and SQL, but value will be converted to uint64 type.
+// A value bigger than math.MaxInt64 is sent to the database as a string
+type Uint64 = Integer[uint64]
@@ -0,0 +1,347 @@
+package extratypes
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validUint16 = Uint16{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMaxUint16 = Uint16{
+		Val: math.MaxUint16,
+		Nil: false,
+	}
+
+	validMinusUint16 = Uint16{
+		Val: 0,
+		Nil: false,
+	}
+
+	nilUint16 = Uint16{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testUint16JSON      = []byte("10")
+	testUint16MaxJSON   = []byte(strconv.FormatUint(math.MaxUint16, 10))
+	testUint16MinusJSON = []byte("-10")
+	testUint16NilJSON   = []byte("null")
+	testUint16ErrJSON   = []byte("a")
+	testUint16ValidText = []byte("10")
+	testUint16MaxText   = []byte(strconv.FormatUint(math.MaxUint16, 10))
+	testUint16MinusText = []byte("-10")
+	testUint16NilText   = []byte("")
+)
+
+func TestUint16String(t *testing.T) {
+	i := Uint16{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Uint16{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestUint16Scan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint16
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint16: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint16
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint16: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestUint16Value(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validUint16).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validUint16)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestUint16JSONMarshal(t *testing.T) {
+	t.Run("marshal uint16", func(te *testing.T) {
+		result, err := validUint16.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Uint16 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint16JSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint16JSON, result)
+		}
+
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		result, err := validMaxUint16.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint16 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint16MaxJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint16MaxJSON, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilUint16.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint16 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint16NilJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint16NilJSON, result)
+		}
+
+	})
+}
+
+func TestUint16JSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint16", func(te *testing.T) {
+		var result Uint16
+		err := result.UnmarshalJSON(testUint16JSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint16)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint16
+		err := result.UnmarshalJSON(testUint16MinusJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint16)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint16
+		err := result.UnmarshalJSON(testUint16NilJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint16)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Uint16
+		err := result.UnmarshalJSON(testUint16ErrJSON)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Uint16
+		err := result.UnmarshalJSON([]byte("100000"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxUint16 {
+			te.Errorf("result %v is not %d", result, uint64(math.MaxUint16))
+		}
+	})
+}
+
+func TestUint16TextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validUint16.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint16ValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint16ValidText)
+		}
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		b, err := validMaxUint16.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint16MaxText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint16MaxText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilUint16.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint16NilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint16NilText)
+		}
+
+	})
+}
+
+func TestUint16TextUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint16", func(te *testing.T) {
+		var result Uint16
+		err := result.UnmarshalText(testUint16ValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint16)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint16
+		err := result.UnmarshalText(testUint16MinusText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint16)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint16
+		err := result.UnmarshalText(testUint16NilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint16)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilUint16)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint16)
+		}
+
+	})
+
+}
@@ -0,0 +1,347 @@
+package extratypes
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validUint32 = Uint32{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMaxUint32 = Uint32{
+		Val: math.MaxUint32,
+		Nil: false,
+	}
+
+	validMinusUint32 = Uint32{
+		Val: 0,
+		Nil: false,
+	}
+
+	nilUint32 = Uint32{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testUint32JSON      = []byte("10")
+	testUint32MaxJSON   = []byte(strconv.FormatUint(math.MaxUint32, 10))
+	testUint32MinusJSON = []byte("-10")
+	testUint32NilJSON   = []byte("null")
+	testUint32ErrJSON   = []byte("a")
+	testUint32ValidText = []byte("10")
+	testUint32MaxText   = []byte(strconv.FormatUint(math.MaxUint32, 10))
+	testUint32MinusText = []byte("-10")
+	testUint32NilText   = []byte("")
+)
+
+func TestUint32String(t *testing.T) {
+	i := Uint32{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Uint32{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestUint32Scan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint32
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint32: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint32
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint32: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestUint32Value(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validUint32).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validUint32)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestUint32JSONMarshal(t *testing.T) {
+	t.Run("marshal uint32", func(te *testing.T) {
+		result, err := validUint32.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Uint32 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint32JSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint32JSON, result)
+		}
+
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		result, err := validMaxUint32.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint32 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint32MaxJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint32MaxJSON, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilUint32.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint32 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint32NilJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint32NilJSON, result)
+		}
+
+	})
+}
+
+func TestUint32JSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint32", func(te *testing.T) {
+		var result Uint32
+		err := result.UnmarshalJSON(testUint32JSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint32)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint32
+		err := result.UnmarshalJSON(testUint32MinusJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint32)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint32
+		err := result.UnmarshalJSON(testUint32NilJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint32)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Uint32
+		err := result.UnmarshalJSON(testUint32ErrJSON)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Uint32
+		err := result.UnmarshalJSON([]byte("99999999999"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxUint32 {
+			te.Errorf("result %v is not %d", result, uint64(math.MaxUint32))
+		}
+	})
+}
+
+func TestUint32TextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validUint32.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint32ValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint32ValidText)
+		}
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		b, err := validMaxUint32.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint32MaxText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint32MaxText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilUint32.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint32NilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint32NilText)
+		}
+
+	})
+}
+
+func TestUint32TextUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint32", func(te *testing.T) {
+		var result Uint32
+		err := result.UnmarshalText(testUint32ValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint32)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint32
+		err := result.UnmarshalText(testUint32MinusText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint32)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint32
+		err := result.UnmarshalText(testUint32NilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint32)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilUint32)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint32)
+		}
+
+	})
+
+}
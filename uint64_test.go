@@ -0,0 +1,356 @@
+package extratypes
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validUint64 = Uint64{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMaxUint64 = Uint64{
+		Val: math.MaxUint64,
+		Nil: false,
+	}
+
+	validMinusUint64 = Uint64{
+		Val: 0,
+		Nil: false,
+	}
+
+	nilUint64 = Uint64{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testUint64JSON      = []byte("10")
+	testUint64MaxJSON   = []byte(strconv.FormatUint(math.MaxUint64, 10))
+	testUint64MinusJSON = []byte("-10")
+	testUint64NilJSON   = []byte("null")
+	testUint64ErrJSON   = []byte("a")
+	testUint64ValidText = []byte("10")
+	testUint64MaxText   = []byte(strconv.FormatUint(math.MaxUint64, 10))
+	testUint64MinusText = []byte("-10")
+	testUint64NilText   = []byte("")
+)
+
+func TestUint64String(t *testing.T) {
+	i := Uint64{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Uint64{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestUint64Scan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint64
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint64: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint64
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint64: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestUint64Value(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validUint64).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validUint64)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+	v, err := validMaxUint64.Value()
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	if v != strconv.FormatUint(math.MaxUint64, 10) {
+		t.Errorf("v (%T) %v is not a string of %d", v, v, uint64(math.MaxUint64))
+	}
+
+}
+
+func TestUint64JSONMarshal(t *testing.T) {
+	t.Run("marshal uint64", func(te *testing.T) {
+		result, err := validUint64.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Uint64 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint64JSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint64JSON, result)
+		}
+
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		result, err := validMaxUint64.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint64 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint64MaxJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint64MaxJSON, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilUint64.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint64 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint64NilJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint64NilJSON, result)
+		}
+
+	})
+}
+
+func TestUint64JSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint64", func(te *testing.T) {
+		var result Uint64
+		err := result.UnmarshalJSON(testUint64JSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint64)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint64
+		err := result.UnmarshalJSON(testUint64MinusJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint64)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint64
+		err := result.UnmarshalJSON(testUint64NilJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint64)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Uint64
+		err := result.UnmarshalJSON(testUint64ErrJSON)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Uint64
+		err := result.UnmarshalJSON([]byte("99999999999999999999"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxUint64 {
+			te.Errorf("result %v is not %d", result, uint64(math.MaxUint64))
+		}
+	})
+}
+
+func TestUint64TextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validUint64.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint64ValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint64ValidText)
+		}
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		b, err := validMaxUint64.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint64MaxText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint64MaxText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilUint64.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint64NilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint64NilText)
+		}
+
+	})
+}
+
+func TestUint64TextUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint64", func(te *testing.T) {
+		var result Uint64
+		err := result.UnmarshalText(testUint64ValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint64)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint64
+		err := result.UnmarshalText(testUint64MinusText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint64)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint64
+		err := result.UnmarshalText(testUint64NilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint64)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilUint64)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint64)
+		}
+
+	})
+
+}
@@ -0,0 +1,347 @@
+package extratypes
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validUint8 = Uint8{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMaxUint8 = Uint8{
+		Val: math.MaxUint8,
+		Nil: false,
+	}
+
+	validMinusUint8 = Uint8{
+		Val: 0,
+		Nil: false,
+	}
+
+	nilUint8 = Uint8{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testUint8JSON      = []byte("10")
+	testUint8MaxJSON   = []byte(strconv.FormatUint(math.MaxUint8, 10))
+	testUint8MinusJSON = []byte("-10")
+	testUint8NilJSON   = []byte("null")
+	testUint8ErrJSON   = []byte("a")
+	testUint8ValidText = []byte("10")
+	testUint8MaxText   = []byte(strconv.FormatUint(math.MaxUint8, 10))
+	testUint8MinusText = []byte("-10")
+	testUint8NilText   = []byte("")
+)
+
+func TestUint8String(t *testing.T) {
+	i := Uint8{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Uint8{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestUint8Scan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint8
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint8: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint8
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint8: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestUint8Value(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validUint8).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validUint8)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+}
+
+func TestUint8JSONMarshal(t *testing.T) {
+	t.Run("marshal uint8", func(te *testing.T) {
+		result, err := validUint8.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Uint8 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint8JSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint8JSON, result)
+		}
+
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		result, err := validMaxUint8.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint8 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint8MaxJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint8MaxJSON, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilUint8.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint8 to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUint8NilJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUint8NilJSON, result)
+		}
+
+	})
+}
+
+func TestUint8JSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint8", func(te *testing.T) {
+		var result Uint8
+		err := result.UnmarshalJSON(testUint8JSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint8)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint8
+		err := result.UnmarshalJSON(testUint8MinusJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint8)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint8
+		err := result.UnmarshalJSON(testUint8NilJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint8)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Uint8
+		err := result.UnmarshalJSON(testUint8ErrJSON)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Uint8
+		err := result.UnmarshalJSON([]byte("1000"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != math.MaxUint8 {
+			te.Errorf("result %v is not %d", result, uint64(math.MaxUint8))
+		}
+	})
+}
+
+func TestUint8TextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validUint8.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint8ValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint8ValidText)
+		}
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		b, err := validMaxUint8.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint8MaxText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint8MaxText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilUint8.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUint8NilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUint8NilText)
+		}
+
+	})
+}
+
+func TestUint8TextUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint8", func(te *testing.T) {
+		var result Uint8
+		err := result.UnmarshalText(testUint8ValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint8)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint8
+		err := result.UnmarshalText(testUint8MinusText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint8)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint8
+		err := result.UnmarshalText(testUint8NilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint8)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilUint8)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint8)
+		}
+
+	})
+
+}
@@ -0,0 +1,355 @@
+package extratypes
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var (
+	validUint = Uint{
+		Val: 10,
+		Nil: false,
+	}
+
+	validMaxUint = Uint{
+		Val: maxUint,
+		Nil: false,
+	}
+
+	validMinusUint = Uint{
+		Val: 0,
+		Nil: false,
+	}
+
+	nilUint = Uint{
+		Val: 0,
+		Nil: true,
+	}
+)
+
+var (
+	testUintJSON      = []byte("10")
+	testUintMaxJSON   = []byte(strconv.FormatUint(uint64(maxUint), 10))
+	testUintMinusJSON = []byte("-10")
+	testUintNilJSON   = []byte("null")
+	testUintErrJSON   = []byte("a")
+	testUintValidText = []byte("10")
+	testUintMaxText   = []byte(strconv.FormatUint(uint64(maxUint), 10))
+	testUintMinusText = []byte("-10")
+	testUintNilText   = []byte("")
+)
+
+func TestUintString(t *testing.T) {
+	i := Uint{
+		Val: 10,
+		Nil: false,
+	}
+
+	if i.String() != "10" {
+		t.Errorf("i [%s] is not 10", i)
+	}
+
+	i = Uint{
+		Val: 0,
+		Nil: true,
+	}
+
+	if i.String() != "nil" {
+		t.Errorf("i [%s] is not nil", i)
+	}
+}
+
+func TestUintScan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("No error was expected but have: %s", err)
+	}
+	defer db.Close()
+
+	t.Run("test scan valid", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(-1).
+			AddRow(1).
+			AddRow(1.1)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint: %s", err)
+			}
+			if i.Nil {
+				te.Errorf("i [%d] not expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+
+	t.Run("test nil", func(te *testing.T) {
+		rows := mock.NewRows([]string{"i"}).
+			AddRow(nil)
+
+		mock.ExpectQuery("SELECT").WillReturnRows(rows)
+		rs, _ := db.Query("SELECT")
+		defer rs.Close()
+		for rs.Next() {
+			var i Uint
+			err := rs.Scan(&i)
+			if err != nil {
+				t.Errorf("Unable to scan Uint: %s", err)
+			}
+			if !i.Nil {
+				te.Errorf("i [%d] expected to be nil", i.Val)
+			}
+		}
+
+		if rs.Err() != nil {
+			te.Errorf("got rows error: %s", rs.Err())
+		}
+	})
+}
+
+func TestUintValue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Errorf("error creating mock database: %s", err)
+		return
+	}
+	defer db.Close()
+
+	mock.ExpectExec("^INSERT (.+)").WithArgs(validUint).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err = db.Exec("INSERT (i)", validUint)
+	if err != nil {
+		t.Errorf("Unable to insert record: %s", err)
+	}
+
+	v, err := validMaxUint.Value()
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	if v != strconv.FormatUint(uint64(maxUint), 10) {
+		t.Errorf("v (%T) %v is not a string of %d", v, v, uint64(maxUint))
+	}
+
+}
+
+func TestUintJSONMarshal(t *testing.T) {
+	t.Run("marshal uint", func(te *testing.T) {
+		result, err := validUint.MarshalJSON()
+		if err != nil {
+			te.Errorf("Error marshaling Uint to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUintJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUintJSON, result)
+		}
+
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		result, err := validMaxUint.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUintMaxJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUintMaxJSON, result)
+		}
+
+	})
+
+	t.Run("marshal nil", func(te *testing.T) {
+		result, err := nilUint.MarshalJSON()
+
+		if err != nil {
+			te.Errorf("Error marshaling Uint to JSON: %s", err)
+		}
+
+		cmp := bytes.Compare(result, testUintNilJSON)
+		if cmp != 0 {
+			te.Errorf("Expected '%s', got '%s'", testUintNilJSON, result)
+		}
+
+	})
+}
+
+func TestUintJSONUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint", func(te *testing.T) {
+		var result Uint
+		err := result.UnmarshalJSON(testUintJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint
+		err := result.UnmarshalJSON(testUintMinusJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint
+		err := result.UnmarshalJSON(testUintNilJSON)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint)
+		}
+
+	})
+
+	t.Run("unmarshal err", func(te *testing.T) {
+		var result Uint
+		err := result.UnmarshalJSON(testUintErrJSON)
+
+		if err == nil {
+			te.Errorf("expected error, but none given")
+		}
+
+	})
+
+	t.Run("unmarshal out of range", func(te *testing.T) {
+		var result Uint
+		err := result.UnmarshalJSON([]byte("99999999999999999999"))
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		if result.Val != maxUint {
+			te.Errorf("result %v is not %d", result, uint64(maxUint))
+		}
+	})
+}
+
+func TestUintTextMarshal(t *testing.T) {
+	t.Run("marshal valid", func(te *testing.T) {
+		b, err := validUint.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUintValidText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUintValidText)
+		}
+	})
+
+	t.Run("marshal max", func(te *testing.T) {
+		b, err := validMaxUint.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUintMaxText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUintMaxText)
+		}
+
+	})
+
+	t.Run("test nil marshal", func(te *testing.T) {
+		b, err := nilUint.MarshalText()
+		if err != nil {
+			te.Errorf("Unexpected err: %s", err)
+		}
+
+		cmp := bytes.Compare(b, testUintNilText)
+		if cmp != 0 {
+			t.Errorf("b %s is not %s", b, testUintNilText)
+		}
+
+	})
+}
+
+func TestUintTextUnmarshal(t *testing.T) {
+	t.Run("unmarshal uint", func(te *testing.T) {
+		var result Uint
+		err := result.UnmarshalText(testUintValidText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validUint)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validUint)
+		}
+	})
+
+	t.Run("unmarshal minus", func(te *testing.T) {
+		var result Uint
+		err := result.UnmarshalText(testUintMinusText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, validMinusUint)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, validMinusUint)
+		}
+	})
+
+	t.Run("unmarshal nil", func(te *testing.T) {
+		var result Uint
+		err := result.UnmarshalText(testUintNilText)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp := reflect.DeepEqual(result, nilUint)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint)
+		}
+
+		err = result.UnmarshalText(nil)
+
+		if err != nil {
+			te.Errorf("Unexpected error: %s", err)
+		}
+
+		cmp = reflect.DeepEqual(result, nilUint)
+		if !cmp {
+			te.Errorf("result %v not equal to %v", result, nilUint)
+		}
+
+	})
+
+}
@@ -1,15 +1,22 @@
 package extratypes
 
 import (
+	"bytes"
+	"database/sql/driver"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
+var driverValueType = reflect.TypeOf((*driver.Value)(nil)).Elem()
+
 const (
 	maxUint = ^uint(0)
 	minUint = 0
@@ -17,31 +24,62 @@ const (
 	minInt  = -maxInt - 1
 )
 
-var (
-	boolMap = map[string]bool{
-		"true": true, "false": false,
-		"yes": true, "no": false,
-		"t": true, "f": false,
-		"y": true, "n": false,
-		"1": true, "0": false, "-1": false,
-	}
-
-	ErrDestUnsupported = errors.New("Unsupported dest type")
-)
-
-// toType copies to dest the value in src, converting it if possible.
+// toType copies to dest the value in src, converting it if possible,
+// using DefaultOptions.
 // An error is returned if the copy would result in loss of information.
 // dest should be a pointer type.
 // If src is nil, then the function return true, and dest remains as-is.
 func toType(src, dest interface{}) (bool, error) {
+	return DefaultOptions.toType(src, dest)
+}
+
+// toType copies to dest the value in src, converting it by the rules of o.
+// The returned error is a *ConversionError.
+func (o Options) toType(src, dest interface{}) (bool, error) {
+	isNil, err := o.assign(src, dest)
+	if err != nil {
+		return isNil, asConversionError(src, targetType(dest), ReasonUnsupported, err)
+	}
+
+	return isNil, nil
+}
+
+// targetType returns the type that dest points to
+func targetType(dest interface{}) reflect.Type {
+	t := reflect.TypeOf(dest)
+	if t != nil && t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+
+	return t
+}
+
+func (o Options) assign(src, dest interface{}) (bool, error) {
 	if src == nil {
 		return true, nil
 	}
 
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return false, ErrDestUnsupported
+	}
+
+	if converter, ok := lookupConverter(v.Type().Elem()); ok {
+		err := converter(src, dest)
+		if err != nil {
+			return false, asConversionError(src, v.Type().Elem(), ReasonSyntax, err)
+		}
+		return false, nil
+	}
+
 	switch dest.(type) {
 	case *string:
 		d := dest.(*string)
-		*d = asString(src)
+		str, err := convertString(src)
+		if err != nil {
+			return false, err
+		}
+		*d = str
 		return false, nil
 	case *[]byte:
 		d := dest.(*[]byte)
@@ -49,94 +87,105 @@ func toType(src, dest interface{}) (bool, error) {
 		return false, nil
 	case *bool:
 		d := dest.(*bool)
-		*d = asBool(src)
+		b, err := o.convertBool(src)
+		if err != nil {
+			return false, err
+		}
+		*d = b
 		return false, nil
+	case *time.Time:
+		d := dest.(*time.Time)
+		t, err := asTime(src)
+		if err != nil {
+			return false, err
+		}
+		*d = t
+		return false, nil
+	case *time.Duration:
+		// a string with units is parsed as a Duration does. A number, and
+		// a string without units such as "5", is nanoseconds, that is
+		// converted by its kind below
+		s, ok := src.(string)
+		if b, isBytes := src.([]byte); isBytes {
+			s, ok = string(b), true
+		}
+
+		if ok {
+			if d, err := parseDuration(strings.TrimSpace(s)); err == nil {
+				*dest.(*time.Duration) = d
+				return false, nil
+			}
+		}
 	}
 
-	v := reflect.ValueOf(dest)
-	switch v.Kind() {
-	case reflect.Ptr:
-		ptr := v.Elem()
-		switch ptr.Kind() {
-		case reflect.Int:
-			d := dest.(*int)
-			i := asInt(src, int64(minInt), int64(maxInt))
-			*d = int(i.(int64))
-			return false, nil
-		case reflect.Int8:
-			d := dest.(*int8)
-			i := asInt(src, math.MinInt8, math.MaxInt8)
-			*d = int8(i.(int64))
-			return false, nil
-		case reflect.Int16:
-			d := dest.(*int16)
-			i := asInt(src, math.MinInt16, math.MaxInt16)
-			*d = int16(i.(int64))
-			return false, nil
-		case reflect.Int32:
-			d := dest.(*int32)
-			i := asInt(src, math.MinInt32, math.MaxInt32)
-			*d = int32(i.(int64))
-			return false, nil
-		case reflect.Int64:
-			d := dest.(*int64)
-			i := asInt(src, math.MinInt64, math.MaxInt64)
-			*d = i.(int64)
-			return false, nil
-
-		case reflect.Uint:
-			d := dest.(*uint)
-			i := asUint(src, 0, uint64(maxUint))
-			*d = uint(i.(uint64))
-			return false, nil
-		case reflect.Uint8:
-			d := dest.(*uint8)
-			i := asUint(src, 0, math.MaxUint8)
-			*d = uint8(i.(uint64))
-			return false, nil
-		case reflect.Uint16:
-			d := dest.(*uint16)
-			i := asUint(src, 0, math.MaxUint16)
-			*d = uint16(i.(uint64))
-			return false, nil
-		case reflect.Uint32:
-			d := dest.(*uint32)
-			i := asUint(src, 0, math.MaxUint32)
-			*d = uint32(i.(uint64))
-			return false, nil
-		case reflect.Uint64:
-			d := dest.(*uint64)
-			i := asUint(src, 0, math.MaxUint64)
-			*d = i.(uint64)
-			return false, nil
+	// named types, such as "type ID int64", are converted by their kind
+	ptr := v.Elem()
+	switch ptr.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		maxRange := int64(^uint64(0) >> (65 - ptr.Type().Bits()))
+		i, err := convertInt(src, -maxRange-1, maxRange, o.Strict)
+		if err != nil {
+			return false, err
+		}
+		ptr.SetInt(i)
+		return false, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		maxRange := ^uint64(0) >> (64 - ptr.Type().Bits())
+		i, err := convertUint(src, 0, maxRange, o.Strict)
+		if err != nil {
+			return false, err
 		}
+		ptr.SetUint(i)
+		return false, nil
+
+	case reflect.String:
+		str, err := convertString(src)
+		if err != nil {
+			return false, err
+		}
+		ptr.SetString(str)
+		return false, nil
+	case reflect.Bool:
+		b, err := o.convertBool(src)
+		if err != nil {
+			return false, err
+		}
+		ptr.SetBool(b)
+		return false, nil
 
+	case reflect.Float32, reflect.Float64:
+		f, err := convertFloat(src, ptr.Type().Bits(), o.Strict, o.AllowNonFinite)
+		if err != nil {
+			return false, err
+		}
+		ptr.SetFloat(f)
+		return false, nil
 	}
 
 	return false, ErrDestUnsupported
 }
 
 func asBool(src interface{}) bool {
+	b, _ := DefaultOptions.convertBool(src)
+	return b
+}
+
+// convertBool converts src into bool. A word is looked up in the
+// vocabulary of o, and a number is true when it is bigger than 0.
+// In strict mode a word that is not in the vocabulary, a number that is
+// not 0 or 1 and a type that is not supported return an error.
+func (o Options) convertBool(src interface{}) (bool, error) {
 	if src == nil {
-		return false
+		return false, nil
 	}
-	switch src.(type) {
+	switch v := src.(type) {
 	case bool:
-		return src.(bool)
+		return v, nil
 	case string:
-		s := strings.ToLower(src.(string))
-		status, ok := boolMap[s]
-		if !ok {
-			return false
-		}
-		return status
+		return o.lookupBool(v)
 	case []byte:
-		s := strings.ToLower(string(src.([]byte)))
-		status, ok := boolMap[s]
-		if !ok {
-			return false
-		}
-		return status
+		return o.lookupBool(string(v))
 	}
 
 	val := reflect.ValueOf(src)
@@ -144,118 +193,406 @@ func asBool(src interface{}) bool {
 	switch v {
 	case reflect.Int8, reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64:
 		i := val.Int()
-		return i > 0
+		if o.Strict && i != 0 && i != 1 {
+			return false, ErrOverflow
+		}
+		return i > 0, nil
 	case reflect.Uint8, reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		i := val.Uint()
-		return i > 0
+		if o.Strict && i > 1 {
+			return false, ErrOverflow
+		}
+		return i > 0, nil
 	case reflect.Float32, reflect.Float64:
-		f := math.Floor(val.Float())
-		return int64(f) > 0
+		f := val.Float()
+		if o.Strict && f != 0 && f != 1 {
+			if f > 0 && f < 1 {
+				return false, ErrTruncated
+			}
+			return false, ErrOverflow
+		}
+		return int64(math.Floor(f)) > 0, nil
+	}
+
+	if o.Strict {
+		return false, ErrUnsupported
 	}
 
-	return false
+	return false, nil
+}
+
+// lookupBool returns the bool of the word s in the vocabulary of o, or in
+// boolFormatWords when the vocabulary does not have it. A word that is not
+// there is false, or ErrSyntax when o.RejectUnknownBool or o.Strict is set.
+func (o Options) lookupBool(s string) (bool, error) {
+	b, ok := o.boolVocabulary().Lookup(s)
+	if !ok {
+		b, ok = boolFormatWords.Lookup(s)
+	}
+
+	if !ok && (o.RejectUnknownBool || o.Strict) {
+		return false, ErrSyntax
+	}
+
+	return b, nil
 }
 
 func asInt(src interface{}, minRange, maxRange int64) interface{} {
+	i, _ := convertInt(src, minRange, maxRange, false)
+	return i
+}
+
+// convertInt converts src into an int64 between minRange and maxRange.
+// When strict is false, a value outside of the range is clamped, and a
+// value that cannot be converted becomes 0. When strict is true, an error
+// is returned instead.
+func convertInt(src interface{}, minRange, maxRange int64, strict bool) (int64, error) {
 	val := reflect.ValueOf(src)
 	v := val.Kind()
 	switch v {
 	case reflect.Int8, reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64:
 		i := val.Int()
 		if i > maxRange {
-			return maxRange
+			if strict {
+				return 0, ErrOverflow
+			}
+			return maxRange, nil
 		}
 		if i < minRange {
-			return minRange
+			if strict {
+				return 0, ErrOverflow
+			}
+			return minRange, nil
 		}
-		return i
+		return i, nil
 	case reflect.Uint8, reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		i := val.Uint()
 		if i > uint64(maxRange) {
-			return maxRange
+			if strict {
+				return 0, ErrOverflow
+			}
+			return maxRange, nil
 		}
-		return int64(i)
+		return int64(i), nil
 	case reflect.Float32, reflect.Float64:
-		f := math.Floor(val.Float())
-		return asInt(int64(f), minRange, maxRange)
+		f := val.Float()
+		if strict {
+			if math.IsNaN(f) || f >= math.MaxInt64 || f < math.MinInt64 {
+				return 0, ErrOverflow
+			}
+			if f != math.Trunc(f) {
+				return 0, ErrTruncated
+			}
+			return convertInt(int64(f), minRange, maxRange, strict)
+		}
+
+		f = math.Floor(f)
+		if f >= math.MaxInt64 {
+			return maxRange, nil
+		}
+		if f < math.MinInt64 {
+			return minRange, nil
+		}
+		return convertInt(int64(f), minRange, maxRange, strict)
 	case reflect.String:
 		s := val.String()
+		if strict {
+			return parseIntStrict(s, minRange, maxRange)
+		}
+
 		if s == "" {
-			return int64(0)
+			return 0, nil
 		}
 
 		if s[0] == '-' { // signed
 			i, err := strconv.ParseInt(s, 10, 64)
 			if err != nil {
-				return int64(0)
+				return 0, nil
 			}
 
-			return asInt(i, minRange, maxRange)
+			return convertInt(i, minRange, maxRange, strict)
 		}
 
 		i, err := strconv.ParseUint(s, 10, 64)
 		if err != nil {
-			return int64(0)
+			return 0, nil
 		}
-		return asInt(i, minRange, maxRange)
+		return convertInt(i, minRange, maxRange, strict)
 	}
 
 	switch src.(type) {
 	case []byte:
 		s := string(src.([]byte))
-		return asInt(s, minRange, maxRange)
+		return convertInt(s, minRange, maxRange, strict)
+	}
+
+	if strict {
+		return 0, ErrUnsupported
+	}
+	return 0, nil
+}
+
+// parseIntStrict parses s as a whole number, or as a floating point number
+// that does not have a fraction.
+func parseIntStrict(s string, minRange, maxRange int64) (int64, error) {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return convertInt(i, minRange, maxRange, true)
+	}
+
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrOverflow
 	}
 
-	return int64(0)
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, ErrSyntax
+	}
 
+	return convertInt(f, minRange, maxRange, true)
 }
 
 func asUint(src interface{}, minRange, maxRange uint64) interface{} {
+	i, _ := convertUint(src, minRange, maxRange, false)
+	return i
+}
+
+// convertUint converts src into an uint64 between minRange and maxRange.
+// When strict is false, a value outside of the range (including a
+// negative one) is clamped, and a value that cannot be converted becomes 0.
+// When strict is true, an error is returned instead.
+func convertUint(src interface{}, minRange, maxRange uint64, strict bool) (uint64, error) {
 	val := reflect.ValueOf(src)
 	v := val.Kind()
 	switch v {
 	case reflect.Int8, reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64:
 		i := val.Int()
-		if uint64(i) > maxRange {
-			return maxRange
-		}
-		if uint64(i) < minRange {
-			return minRange
+		if i < 0 {
+			if strict {
+				return 0, ErrNegative
+			}
+			return minRange, nil
 		}
-		return uint64(i)
+		return convertUint(uint64(i), minRange, maxRange, strict)
 	case reflect.Uint8, reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		i := val.Uint()
-		if i > maxRange {
-			return maxRange
+		if i > maxRange || i < minRange {
+			if strict {
+				return 0, ErrOverflow
+			}
+			if i > maxRange {
+				return maxRange, nil
+			}
+			return minRange, nil
 		}
-		return i
+		return i, nil
 	case reflect.Float32, reflect.Float64:
-		f := math.Floor(val.Float())
-		return asUint(uint64(f), minRange, maxRange)
+		f := val.Float()
+		if strict {
+			if f < 0 {
+				return 0, ErrNegative
+			}
+			if math.IsNaN(f) || f >= math.MaxUint64 {
+				return 0, ErrOverflow
+			}
+			if f != math.Trunc(f) {
+				return 0, ErrTruncated
+			}
+			return convertUint(uint64(f), minRange, maxRange, strict)
+		}
+
+		f = math.Floor(f)
+		if f < 0 {
+			return minRange, nil
+		}
+		if f >= math.MaxUint64 {
+			return maxRange, nil
+		}
+		return convertUint(uint64(f), minRange, maxRange, strict)
 	case reflect.String:
 		s := val.String()
+		if strict {
+			return parseUintStrict(s, minRange, maxRange)
+		}
+
 		if s == "" {
-			return uint64(0)
+			return 0, nil
 		}
 
 		if s[0] == '-' { // signed
-			return minRange
+			return minRange, nil
 		}
 
 		i, err := strconv.ParseUint(s, 10, 64)
 		if err != nil {
-			return uint64(0)
+			return 0, nil
 		}
-		return asUint(i, minRange, maxRange)
+		return convertUint(i, minRange, maxRange, strict)
 	}
 
 	switch src.(type) {
 	case []byte:
 		s := string(src.([]byte))
-		return asUint(s, minRange, maxRange)
+		return convertUint(s, minRange, maxRange, strict)
+	}
+
+	if strict {
+		return 0, ErrUnsupported
+	}
+	return 0, nil
+}
+
+// parseUintStrict parses s as a whole number, or as a floating point
+// number that does not have a fraction.
+func parseUintStrict(s string, minRange, maxRange uint64) (uint64, error) {
+	i, err := strconv.ParseUint(s, 10, 64)
+	if err == nil {
+		return convertUint(i, minRange, maxRange, true)
+	}
+
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrOverflow
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, ErrSyntax
+	}
+
+	return convertUint(f, minRange, maxRange, true)
+}
+
+// convertFloat converts src into a float64 that fits into a float of
+// the given bits (32 or 64).
+// When strict is false, a value outside of the range is clamped, and a
+// value that cannot be converted becomes 0. When strict is true, an error
+// is returned instead, as it is for a whole number that loses precision.
+// NaN and infinity are converted only when allowNonFinite is true.
+func convertFloat(src interface{}, bits int, strict, allowNonFinite bool) (float64, error) {
+	maxRange := math.MaxFloat64
+	if bits == 32 {
+		maxRange = math.MaxFloat32
+	}
+
+	val := reflect.ValueOf(src)
+	switch val.Kind() {
+	case reflect.Int8, reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := val.Int()
+		f := roundFloat(float64(i), bits)
+		if strict && (f >= math.MaxInt64 || int64(f) != i) {
+			return 0, ErrTruncated
+		}
+		return f, nil
+	case reflect.Uint8, reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i := val.Uint()
+		f := roundFloat(float64(i), bits)
+		if strict && (f >= math.MaxUint64 || uint64(f) != i) {
+			return 0, ErrTruncated
+		}
+		return f, nil
+	case reflect.Float32, reflect.Float64:
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			if allowNonFinite {
+				return f, nil
+			}
+			if strict {
+				return 0, ErrSyntax
+			}
+			return 0, nil
+		}
+
+		if math.Abs(f) > maxRange {
+			if strict {
+				return 0, ErrOverflow
+			}
+			return math.Copysign(maxRange, f), nil
+		}
+		return roundFloat(f, bits), nil
+	case reflect.String:
+		s := val.String()
+		f, err := strconv.ParseFloat(s, bits)
+		if err != nil && errors.Is(err, strconv.ErrRange) {
+			if strict {
+				return 0, ErrOverflow
+			}
+			if math.IsInf(f, 0) {
+				return math.Copysign(maxRange, f), nil
+			}
+			return 0, nil
+		}
+
+		if err != nil {
+			if strict {
+				return 0, ErrSyntax
+			}
+			return 0, nil
+		}
+
+		// ParseFloat accepts "NaN" and "Inf", and they follow the same
+		// rules as the float values
+		return convertFloat(f, bits, strict, allowNonFinite)
+	}
+
+	switch src.(type) {
+	case []byte:
+		s := string(src.([]byte))
+		return convertFloat(s, bits, strict, allowNonFinite)
+	}
+
+	if strict {
+		return 0, ErrUnsupported
+	}
+	return 0, nil
+}
+
+// nonFiniteString returns "NaN", "+Inf" or "-Inf" when src is a float
+// that holds one of them
+func nonFiniteString(src interface{}) (string, bool) {
+	rv := reflect.ValueOf(src)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return strconv.FormatFloat(f, 'g', -1, 64), true
+		}
 	}
 
-	return uint64(0)
+	return "", false
+}
+
+// roundFloat rounds f to the nearest float of the given bits
+func roundFloat(f float64, bits int) float64 {
+	if bits == 32 {
+		return float64(float32(f))
+	}
+
+	return f
+}
+
+// convertString converts src, that is a string, bytes, a number, a bool or
+// a time.Time, into string. Any other value, such as a map, a slice or a
+// struct, returns ErrUnsupported instead of its fmt representation.
+func convertString(src interface{}) (string, error) {
+	switch src.(type) {
+	case string, []byte, time.Time:
+		return asString(src), nil
+	}
+
+	rv := reflect.ValueOf(src)
+	switch rv.Kind() {
+	case reflect.Slice:
+		// a named []byte, such as json.RawMessage
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return string(rv.Bytes()), nil
+		}
+		return "", ErrUnsupported
+	case reflect.Map, reflect.Array, reflect.Struct, reflect.Ptr,
+		reflect.Interface, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return "", ErrUnsupported
+	}
+
+	return asString(src), nil
 }
 
 func asString(src interface{}) string {
@@ -321,6 +658,40 @@ func asByteSlice(src interface{}) []byte {
 	return nil
 }
 
+// asDriverValue converts src into one of the types that driver.Value
+// can hold. Unsigned numbers that do not fit into int64 are returned as
+// a string.
+func asDriverValue(src interface{}) (driver.Value, error) {
+	if valuer, ok := src.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	switch v := src.(type) {
+	case nil, int64, float64, bool, []byte, string, time.Time:
+		return v, nil
+	}
+
+	rv := reflect.ValueOf(src)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return strconv.FormatUint(u, 10), nil
+		}
+		return int64(u), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.String:
+		return rv.String(), nil
+	}
+
+	return nil, newConversionError(src, driverValueType, ReasonUnsupported, nil)
+}
+
 func asBytes(buf []byte, rv reflect.Value) (b []byte, ok bool) {
 	switch rv.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -349,6 +720,52 @@ func strconvErr(err error) error {
 	return err
 }
 
+// decodeJSON unmarshals b like json.Unmarshal into interface{} does, but
+// keeps numbers as json.Number, so they do not lose precision by passing
+// through float64. Use fromJSONNumber to turn them into Go numbers.
+func decodeJSON(b []byte) (interface{}, error) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err := dec.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid data after top-level value")
+	}
+
+	return v, nil
+}
+
+// fromJSONNumber replaces every json.Number in v with int64, uint64 or
+// float64, whichever can hold it.
+func fromJSONNumber(v interface{}) interface{} {
+	switch val := v.(type) {
+	case json.Number:
+		s := val.String()
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return u
+		}
+		f, _ := strconv.ParseFloat(s, 64)
+		return f
+	case []interface{}:
+		for i := range val {
+			val[i] = fromJSONNumber(val[i])
+		}
+	case map[string]interface{}:
+		for k := range val {
+			val[k] = fromJSONNumber(val[k])
+		}
+	}
+
+	return v
+}
+
 func cloneBytes(b []byte) []byte {
 	if b == nil {
 		return nil
@@ -2,6 +2,7 @@ package extratypes
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -639,6 +640,24 @@ func TestAsUintFloatBig(t *testing.T) {
 	}
 }
 
+func TestAsUintNegativeInt(t *testing.T) {
+	src := -10
+	dest := asUint(src, 0, math.MaxUint8)
+
+	if dest.(uint64) != 0 {
+		t.Errorf("dest [%d] is not 0", dest)
+	}
+}
+
+func TestAsUintNegativeFloat(t *testing.T) {
+	src := -10.5
+	dest := asUint(src, 0, math.MaxUint8)
+
+	if dest.(uint64) != 0 {
+		t.Errorf("dest [%d] is not 0", dest)
+	}
+}
+
 func TestAsUintRange(t *testing.T) {
 	min := uint64(5)
 	src := 1
@@ -1191,3 +1210,40 @@ func TestToTypeUnsupported(t *testing.T) {
 		t.Errorf("Expected err to be %s, but %s provided", ErrDestUnsupported, err)
 	}
 }
+
+func TestAsDriverValue(t *testing.T) {
+	type toCheck = struct {
+		src      interface{}
+		expected driver.Value
+		hasError bool
+	}
+
+	checks := []toCheck{
+		toCheck{src: nil, expected: nil},
+		toCheck{src: int8(-8), expected: int64(-8)},
+		toCheck{src: uint16(16), expected: int64(16)},
+		toCheck{src: uint64(math.MaxUint64), expected: "18446744073709551615"},
+		toCheck{src: float32(1.5), expected: float64(1.5)},
+		toCheck{src: "a", expected: "a"},
+		toCheck{src: true, expected: true},
+		toCheck{src: Int{Val: 1}, expected: int64(1)},
+		toCheck{src: struct{}{}, expected: nil, hasError: true},
+	}
+
+	for _, check := range checks {
+		result, err := asDriverValue(check.src)
+		if check.hasError && err == nil {
+			t.Errorf("Expected error for %+v, but none given", check.src)
+			continue
+		}
+
+		if !check.hasError && err != nil {
+			t.Errorf("Unexpected error for %+v: %s", check.src, err)
+			continue
+		}
+
+		if result != check.expected {
+			t.Errorf("Expected (%T) %v, got (%T) %v", check.expected, check.expected, result, result)
+		}
+	}
+}